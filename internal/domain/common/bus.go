@@ -0,0 +1,98 @@
+// domain/common/bus.go
+package common
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// EventHandler processes a published domain event
+type EventHandler func(event DomainEvent) error
+
+// EventBus dispatches domain events to subscribed handlers
+type EventBus struct {
+	mu       sync.RWMutex
+	handlers map[EventType][]subscription
+	all      []subscription
+	nextSeq  int
+}
+
+// subscription keeps registration order across typed and global handlers
+type subscription struct {
+	seq     int
+	handler EventHandler
+}
+
+// NewEventBus creates an event bus
+func NewEventBus() *EventBus {
+	return &EventBus{
+		handlers: make(map[EventType][]subscription),
+	}
+}
+
+// Subscribe registers a handler for a specific event type
+func (b *EventBus) Subscribe(eventType EventType, handler EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[eventType] = append(b.handlers[eventType], subscription{seq: b.nextSeq, handler: handler})
+	b.nextSeq++
+}
+
+// SubscribeAll registers a handler that receives every event
+func (b *EventBus) SubscribeAll(handler EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.all = append(b.all, subscription{seq: b.nextSeq, handler: handler})
+	b.nextSeq++
+}
+
+// Publish delivers an event to its handlers in registration order.
+// Every handler runs even if an earlier one fails; failures are joined
+// into the returned error.
+func (b *EventBus) Publish(event DomainEvent) error {
+	handlers := b.handlersFor(event.GetType())
+
+	var errs []error
+	for _, h := range handlers {
+		if err := b.dispatch(h, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// handlersFor merges typed and global handlers by registration order
+func (b *EventBus) handlersFor(eventType EventType) []EventHandler {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	typed := b.handlers[eventType]
+	merged := make([]EventHandler, 0, len(typed)+len(b.all))
+
+	i, j := 0, 0
+	for i < len(typed) || j < len(b.all) {
+		if j >= len(b.all) || (i < len(typed) && typed[i].seq < b.all[j].seq) {
+			merged = append(merged, typed[i].handler)
+			i++
+		} else {
+			merged = append(merged, b.all[j].handler)
+			j++
+		}
+	}
+
+	return merged
+}
+
+// dispatch runs a single handler, converting panics into errors
+func (b *EventBus) dispatch(handler EventHandler, event DomainEvent) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler for %s panicked: %v", event.GetType(), r)
+		}
+	}()
+	return handler(event)
+}
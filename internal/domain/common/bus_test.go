@@ -0,0 +1,126 @@
+// domain/common/bus_test.go
+package common
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEventBusPublishOrder(t *testing.T) {
+	tests := []struct {
+		name  string
+		event DomainEvent
+		want  []string
+	}{
+		{"typed and global interleaved", BaseEvent{ID: "e1", Type: EventGoalScored}, []string{"all-1", "goal-1", "all-2", "goal-2"}},
+		{"only global handlers for other types", BaseEvent{ID: "e2", Type: EventCardIssued}, []string{"all-1", "all-2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := NewEventBus()
+			var got []string
+			record := func(label string) EventHandler {
+				return func(DomainEvent) error {
+					got = append(got, label)
+					return nil
+				}
+			}
+			bus.SubscribeAll(record("all-1"))
+			bus.Subscribe(EventGoalScored, record("goal-1"))
+			bus.SubscribeAll(record("all-2"))
+			bus.Subscribe(EventGoalScored, record("goal-2"))
+
+			if err := bus.Publish(tt.event); err != nil {
+				t.Fatalf("Publish: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("handlers ran %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventBusPublishFailures(t *testing.T) {
+	errFirst := errors.New("first failed")
+
+	tests := []struct {
+		name     string
+		handlers []EventHandler
+		wantRuns int
+		wantErrs []string
+		wantIs   error
+	}{
+		{
+			name:     "no handlers",
+			wantRuns: 0,
+		},
+		{
+			name: "error does not stop later handlers",
+			handlers: []EventHandler{
+				func(DomainEvent) error { return errFirst },
+				func(DomainEvent) error { return nil },
+			},
+			wantRuns: 2,
+			wantErrs: []string{"first failed"},
+			wantIs:   errFirst,
+		},
+		{
+			name: "panic is reported as an error",
+			handlers: []EventHandler{
+				func(DomainEvent) error { panic("boom") },
+				func(DomainEvent) error { return nil },
+			},
+			wantRuns: 2,
+			wantErrs: []string{"panicked: boom"},
+		},
+		{
+			name: "failures are joined",
+			handlers: []EventHandler{
+				func(DomainEvent) error { return errFirst },
+				func(DomainEvent) error { panic("boom") },
+			},
+			wantRuns: 2,
+			wantErrs: []string{"first failed", "panicked: boom"},
+			wantIs:   errFirst,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := NewEventBus()
+			runs := 0
+			for _, h := range tt.handlers {
+				h := h
+				bus.Subscribe(EventLineupSet, func(e DomainEvent) error {
+					runs++
+					return h(e)
+				})
+			}
+
+			err := bus.Publish(BaseEvent{ID: "e3", Type: EventLineupSet})
+			if runs != tt.wantRuns {
+				t.Errorf("ran %d handlers, want %d", runs, tt.wantRuns)
+			}
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Errorf("Publish = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Publish = nil, want an error")
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Publish = %q, want it to mention %q", err, want)
+				}
+			}
+			if tt.wantIs != nil && !errors.Is(err, tt.wantIs) {
+				t.Error("joined error does not wrap the handler's error")
+			}
+		})
+	}
+}
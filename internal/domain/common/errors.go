@@ -8,12 +8,70 @@ type DomainError struct {
 	Code    string
 	Message string
 	Details map[string]interface{}
+	Cause   error
 }
 
 func (e DomainError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("[%s] %s: %v", e.Code, e.Message, e.Cause)
+	}
 	return fmt.Sprintf("[%s] %s", e.Code, e.Message)
 }
 
+// Is reports whether target is a domain error with the same code
+func (e DomainError) Is(target error) bool {
+	var other DomainError
+	switch t := target.(type) {
+	case DomainError:
+		other = t
+	case *DomainError:
+		if t == nil {
+			return false
+		}
+		other = *t
+	default:
+		return false
+	}
+	return e.Code == other.Code
+}
+
+// Unwrap returns the underlying cause, if any
+func (e DomainError) Unwrap() error {
+	return e.Cause
+}
+
+// Wrap returns a copy of the error with an underlying cause attached
+func (e DomainError) Wrap(err error) DomainError {
+	e.Details = copyDetails(e.Details)
+	e.Cause = err
+	return e
+}
+
+// WithDetails returns a copy of the error with extra context merged in
+func (e DomainError) WithDetails(details map[string]interface{}) DomainError {
+	merged := copyDetails(e.Details)
+	if merged == nil {
+		merged = make(map[string]interface{}, len(details))
+	}
+	for k, v := range details {
+		merged[k] = v
+	}
+	e.Details = merged
+	return e
+}
+
+// copyDetails avoids sharing the details map between error copies
+func copyDetails(details map[string]interface{}) map[string]interface{} {
+	if details == nil {
+		return nil
+	}
+	copied := make(map[string]interface{}, len(details))
+	for k, v := range details {
+		copied[k] = v
+	}
+	return copied
+}
+
 // Common domain errors
 var (
 	ErrPlayerNotFound = DomainError{
@@ -60,4 +118,101 @@ var (
 		Code:    "FIXTURE_CONFLICT",
 		Message: "Fixture scheduling conflict",
 	}
+
+	ErrInvalidSubstitution = DomainError{
+		Code:    "INVALID_SUBSTITUTION",
+		Message: "Invalid substitution",
+	}
+
+	ErrSubstitutionLimit = DomainError{
+		Code:    "SUBSTITUTION_LIMIT_REACHED",
+		Message: "No substitutions remaining",
+	}
+
+	ErrMissingCaptain = DomainError{
+		Code:    "MISSING_CAPTAIN",
+		Message: "Lineup has no captain among the starters",
+	}
+
+	ErrSquadSizeLimit = DomainError{
+		Code:    "SQUAD_SIZE_LIMIT",
+		Message: "Squad size limit reached",
+	}
+
+	ErrPositionLimit = DomainError{
+		Code:    "POSITION_LIMIT",
+		Message: "Squad already has the maximum players for this position",
+	}
+
+	ErrGoalkeeperQuota = DomainError{
+		Code:    "GOALKEEPER_QUOTA",
+		Message: "Remaining squad places are reserved for goalkeepers",
+	}
+
+	ErrAgeIneligible = DomainError{
+		Code:    "AGE_INELIGIBLE",
+		Message: "Player is too old for this age group",
+	}
+
+	ErrInvalidSellOnClause = DomainError{
+		Code:    "INVALID_SELL_ON_CLAUSE",
+		Message: "Invalid sell-on clause",
+	}
+
+	ErrInvalidBudgetAllocation = DomainError{
+		Code:    "INVALID_BUDGET_ALLOCATION",
+		Message: "Invalid budget allocation",
+	}
+
+	ErrInvalidAttribute = DomainError{
+		Code:    "INVALID_ATTRIBUTE",
+		Message: "Attribute out of range 0-100",
+	}
+
+	ErrInvalidLoan = DomainError{
+		Code:    "INVALID_LOAN",
+		Message: "Invalid loan arrangement",
+	}
+
+	ErrInvalidStatusTransition = DomainError{
+		Code:    "INVALID_STATUS_TRANSITION",
+		Message: "Player cannot move between these statuses",
+	}
 )
+
+// PlayerNotFound returns ErrPlayerNotFound for a specific player
+func PlayerNotFound(playerID string) DomainError {
+	return withSubject(ErrPlayerNotFound, "player_id", playerID)
+}
+
+// TeamNotFound returns ErrTeamNotFound for a specific team
+func TeamNotFound(teamID string) DomainError {
+	return withSubject(ErrTeamNotFound, "team_id", teamID)
+}
+
+// PlayerUnavailable returns ErrPlayerUnavailable for a specific player
+func PlayerUnavailable(playerID string) DomainError {
+	return withSubject(ErrPlayerUnavailable, "player_id", playerID)
+}
+
+// InvalidFormation returns ErrInvalidFormation for a specific formation
+func InvalidFormation(formation string) DomainError {
+	return withSubject(ErrInvalidFormation, "formation", formation)
+}
+
+// MatchAlreadyPlayed returns ErrMatchAlreadyPlayed for a specific match
+func MatchAlreadyPlayed(matchID string) DomainError {
+	return withSubject(ErrMatchAlreadyPlayed, "match_id", matchID)
+}
+
+// SeasonNotActive returns ErrSeasonNotActive for a specific season
+func SeasonNotActive(seasonID string) DomainError {
+	return withSubject(ErrSeasonNotActive, "season_id", seasonID)
+}
+
+// withSubject attaches the offending identifier to the details and message
+func withSubject(base DomainError, key, value string) DomainError {
+	e := base.WithDetails(map[string]interface{}{key: value})
+	e.Message = fmt.Sprintf("%s: %s", base.Message, value)
+	return e
+}
@@ -0,0 +1,76 @@
+// domain/common/errors_test.go
+package common
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestDomainErrorIs(t *testing.T) {
+	cause := errors.New("disk full")
+
+	tests := []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{"same sentinel", ErrPlayerNotFound, ErrPlayerNotFound, true},
+		{"with details", PlayerNotFound("p1"), ErrPlayerNotFound, true},
+		{"pointer target", PlayerNotFound("p1"), &ErrPlayerNotFound, true},
+		{"nil pointer target", PlayerNotFound("p1"), (*DomainError)(nil), false},
+		{"different code", PlayerNotFound("p1"), ErrTeamNotFound, false},
+		{"wrapped by fmt", fmt.Errorf("loading: %w", TeamNotFound("t1")), ErrTeamNotFound, true},
+		{"cause reachable", ErrInvalidTactics.Wrap(cause), cause, true},
+		{"plain error target", ErrInvalidTactics, cause, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(tt.err, tt.target); got != tt.want {
+				t.Errorf("errors.Is(%v, %v) = %v, want %v", tt.err, tt.target, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomainErrorMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		err  DomainError
+		want string
+	}{
+		{"sentinel", ErrInsufficientPlayers, "[INSUFFICIENT_PLAYERS] Not enough players for lineup"},
+		{"with subject", PlayerUnavailable("p7"), "[PLAYER_UNAVAILABLE] Player is unavailable: p7"},
+		{"with cause", ErrInvalidTactics.Wrap(errors.New("no striker")), "[INVALID_TACTICS] Invalid tactical settings: no striker"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomainErrorCopiesDetails(t *testing.T) {
+	base := ErrInvalidFormation.WithDetails(map[string]interface{}{"formation": "4-4-2"})
+	derived := base.WithDetails(map[string]interface{}{"formation": "3-5-2", "reason": "too few defenders"})
+	wrapped := derived.Wrap(errors.New("cause"))
+	wrapped.Details["reason"] = "changed"
+
+	if len(ErrInvalidFormation.Details) != 0 {
+		t.Errorf("sentinel details = %v, want none", ErrInvalidFormation.Details)
+	}
+	if got := base.Details["formation"]; got != "4-4-2" || len(base.Details) != 1 {
+		t.Errorf("base details = %v, want only formation 4-4-2", base.Details)
+	}
+	if got := derived.Details["reason"]; got != "too few defenders" {
+		t.Errorf("derived reason = %v after editing the wrapped copy", got)
+	}
+	if got := InvalidFormation("5-5-0").Details["formation"]; got != "5-5-0" {
+		t.Errorf("InvalidFormation details = %v, want 5-5-0", got)
+	}
+}
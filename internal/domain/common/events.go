@@ -77,6 +77,16 @@ type GoalScoredEvent struct {
 	TeamID   string
 	Minute   int
 	AssistBy string
+	GoalType string // "open_play", "penalty", "free_kick" or "header"
+}
+
+type CardIssuedEvent struct {
+	BaseEvent
+	MatchID  string
+	PlayerID string
+	TeamID   string
+	CardType string // "yellow" or "red"
+	Minute   int
 }
 
 // Player Events
@@ -87,6 +97,13 @@ type PlayerInjuredEvent struct {
 	ExpectedDays int
 }
 
+type PlayerSuspendedEvent struct {
+	BaseEvent
+	PlayerID string
+	Games    int
+	Reason   string
+}
+
 type PlayerTrainedEvent struct {
 	BaseEvent
 	PlayerID       string
@@ -111,3 +128,13 @@ type SeasonStartedEvent struct {
 	StartDate time.Time
 	Teams     []string
 }
+
+type SeasonCompletedEvent struct {
+	BaseEvent
+	SeasonID       string
+	LeagueID       string
+	ChampionID     string
+	FinalStandings []string
+	Promoted       []string
+	Relegated      []string
+}
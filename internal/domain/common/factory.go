@@ -0,0 +1,140 @@
+// domain/common/factory.go
+package common
+
+import (
+	"crypto/rand"
+	"fmt"
+	"time"
+)
+
+// NewEventID generates a random UUID (version 4) for an event
+func NewEventID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		// Fall back to a time-based ID if the system source fails
+		return fmt.Sprintf("evt-%d", time.Now().UnixNano())
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40 // Version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // RFC 4122 variant
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// NewBaseEvent creates a populated base event
+func NewBaseEvent(eventType EventType, aggregateID string) BaseEvent {
+	return BaseEvent{
+		ID:          NewEventID(),
+		Type:        eventType,
+		OccurredAt:  time.Now(),
+		AggregateID: aggregateID,
+	}
+}
+
+// NewMatchScheduledEvent creates a match scheduled event
+func NewMatchScheduledEvent(matchID, homeTeamID, awayTeamID string, scheduledAt time.Time) MatchScheduledEvent {
+	return MatchScheduledEvent{
+		BaseEvent:   NewBaseEvent(EventMatchScheduled, matchID),
+		HomeTeamID:  homeTeamID,
+		AwayTeamID:  awayTeamID,
+		ScheduledAt: scheduledAt,
+	}
+}
+
+// NewMatchCompletedEvent creates a match completed event
+func NewMatchCompletedEvent(matchID string, homeScore, awayScore int, stats map[string]interface{}) MatchCompletedEvent {
+	return MatchCompletedEvent{
+		BaseEvent: NewBaseEvent(EventMatchCompleted, matchID),
+		HomeScore: homeScore,
+		AwayScore: awayScore,
+		Stats:     stats,
+	}
+}
+
+// NewGoalScoredEvent creates a goal scored event
+func NewGoalScoredEvent(matchID, playerID, teamID string, minute int) GoalScoredEvent {
+	return GoalScoredEvent{
+		BaseEvent: NewBaseEvent(EventGoalScored, matchID),
+		MatchID:   matchID,
+		PlayerID:  playerID,
+		TeamID:    teamID,
+		Minute:    minute,
+	}
+}
+
+// NewCardIssuedEvent creates a card issued event
+func NewCardIssuedEvent(matchID, playerID, teamID, cardType string, minute int) CardIssuedEvent {
+	return CardIssuedEvent{
+		BaseEvent: NewBaseEvent(EventCardIssued, matchID),
+		MatchID:   matchID,
+		PlayerID:  playerID,
+		TeamID:    teamID,
+		CardType:  cardType,
+		Minute:    minute,
+	}
+}
+
+// NewPlayerInjuredEvent creates a player injured event
+func NewPlayerInjuredEvent(playerID, injuryType string, expectedDays int) PlayerInjuredEvent {
+	return PlayerInjuredEvent{
+		BaseEvent:    NewBaseEvent(EventPlayerInjured, playerID),
+		PlayerID:     playerID,
+		InjuryType:   injuryType,
+		ExpectedDays: expectedDays,
+	}
+}
+
+// NewPlayerSuspendedEvent creates a player suspended event
+func NewPlayerSuspendedEvent(playerID string, games int, reason string) PlayerSuspendedEvent {
+	return PlayerSuspendedEvent{
+		BaseEvent: NewBaseEvent(EventPlayerSuspended, playerID),
+		PlayerID:  playerID,
+		Games:     games,
+		Reason:    reason,
+	}
+}
+
+// NewPlayerTrainedEvent creates a player trained event
+func NewPlayerTrainedEvent(playerID, trainingType string, attributeGains map[string]int) PlayerTrainedEvent {
+	return PlayerTrainedEvent{
+		BaseEvent:      NewBaseEvent(EventPlayerTrained, playerID),
+		PlayerID:       playerID,
+		TrainingType:   trainingType,
+		AttributeGains: attributeGains,
+	}
+}
+
+// NewLineupSetEvent creates a lineup set event
+func NewLineupSetEvent(teamID, matchID string, playerIDs []string, formation string) LineupSetEvent {
+	return LineupSetEvent{
+		BaseEvent: NewBaseEvent(EventLineupSet, teamID),
+		TeamID:    teamID,
+		MatchID:   matchID,
+		PlayerIDs: playerIDs,
+		Formation: formation,
+	}
+}
+
+// NewSeasonStartedEvent creates a season started event
+func NewSeasonStartedEvent(seasonID, leagueID string, startDate time.Time, teams []string) SeasonStartedEvent {
+	return SeasonStartedEvent{
+		BaseEvent: NewBaseEvent(EventSeasonStarted, seasonID),
+		SeasonID:  seasonID,
+		LeagueID:  leagueID,
+		StartDate: startDate,
+		Teams:     teams,
+	}
+}
+
+// NewSeasonCompletedEvent creates a season completed event
+func NewSeasonCompletedEvent(seasonID, leagueID, championID string, finalStandings, promoted, relegated []string) SeasonCompletedEvent {
+	return SeasonCompletedEvent{
+		BaseEvent:      NewBaseEvent(EventSeasonCompleted, seasonID),
+		SeasonID:       seasonID,
+		LeagueID:       leagueID,
+		ChampionID:     championID,
+		FinalStandings: finalStandings,
+		Promoted:       promoted,
+		Relegated:      relegated,
+	}
+}
@@ -0,0 +1,138 @@
+// domain/common/factory_test.go
+package common
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+)
+
+// uuidV4 matches the textual form of a version 4 UUID
+var uuidV4 = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+// withoutBase returns a copy of the event with its base fields cleared,
+// leaving only the payload
+func withoutBase(e DomainEvent) DomainEvent {
+	v := reflect.New(reflect.TypeOf(e)).Elem()
+	v.Set(reflect.ValueOf(e))
+	base := v.FieldByName("BaseEvent")
+	base.Set(reflect.Zero(base.Type()))
+	return v.Interface().(DomainEvent)
+}
+
+func TestNewEventID(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		id := NewEventID()
+		if !uuidV4.MatchString(id) {
+			t.Fatalf("NewEventID() = %q, want a version 4 UUID", id)
+		}
+		if seen[id] {
+			t.Fatalf("NewEventID() repeated %q", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestEventConstructors(t *testing.T) {
+	scheduled := time.Date(2026, 8, 15, 15, 0, 0, 0, time.UTC)
+	stats := map[string]interface{}{"attendance": 41000.0}
+	gains := map[string]int{"finishing": 1}
+
+	tests := []struct {
+		name          string
+		event         DomainEvent
+		wantType      EventType
+		wantAggregate string
+		wantPayload   DomainEvent
+	}{
+		{
+			"match scheduled",
+			NewMatchScheduledEvent("m1", "home", "away", scheduled),
+			EventMatchScheduled, "m1",
+			MatchScheduledEvent{HomeTeamID: "home", AwayTeamID: "away", ScheduledAt: scheduled},
+		},
+		{
+			"match completed",
+			NewMatchCompletedEvent("m1", 2, 1, stats),
+			EventMatchCompleted, "m1",
+			MatchCompletedEvent{HomeScore: 2, AwayScore: 1, Stats: stats},
+		},
+		{
+			"goal scored",
+			NewGoalScoredEvent("m1", "p1", "t1", 63),
+			EventGoalScored, "m1",
+			GoalScoredEvent{MatchID: "m1", PlayerID: "p1", TeamID: "t1", Minute: 63},
+		},
+		{
+			"card issued",
+			NewCardIssuedEvent("m1", "p1", "t1", "yellow", 12),
+			EventCardIssued, "m1",
+			CardIssuedEvent{MatchID: "m1", PlayerID: "p1", TeamID: "t1", CardType: "yellow", Minute: 12},
+		},
+		{
+			"player injured",
+			NewPlayerInjuredEvent("p1", "hamstring", 21),
+			EventPlayerInjured, "p1",
+			PlayerInjuredEvent{PlayerID: "p1", InjuryType: "hamstring", ExpectedDays: 21},
+		},
+		{
+			"player suspended",
+			NewPlayerSuspendedEvent("p1", 3, "red card"),
+			EventPlayerSuspended, "p1",
+			PlayerSuspendedEvent{PlayerID: "p1", Games: 3, Reason: "red card"},
+		},
+		{
+			"player trained",
+			NewPlayerTrainedEvent("p1", "finishing", gains),
+			EventPlayerTrained, "p1",
+			PlayerTrainedEvent{PlayerID: "p1", TrainingType: "finishing", AttributeGains: gains},
+		},
+		{
+			"lineup set",
+			NewLineupSetEvent("t1", "m1", []string{"p1", "p2"}, "4-3-3"),
+			EventLineupSet, "t1",
+			LineupSetEvent{TeamID: "t1", MatchID: "m1", PlayerIDs: []string{"p1", "p2"}, Formation: "4-3-3"},
+		},
+		{
+			"season started",
+			NewSeasonStartedEvent("s1", "l1", scheduled, []string{"t1", "t2"}),
+			EventSeasonStarted, "s1",
+			SeasonStartedEvent{SeasonID: "s1", LeagueID: "l1", StartDate: scheduled, Teams: []string{"t1", "t2"}},
+		},
+		{
+			"season completed",
+			NewSeasonCompletedEvent("s1", "l1", "t1", []string{"t1", "t2"}, []string{"t3"}, []string{"t2"}),
+			EventSeasonCompleted, "s1",
+			SeasonCompletedEvent{
+				SeasonID:       "s1",
+				LeagueID:       "l1",
+				ChampionID:     "t1",
+				FinalStandings: []string{"t1", "t2"},
+				Promoted:       []string{"t3"},
+				Relegated:      []string{"t2"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !uuidV4.MatchString(tt.event.GetID()) {
+				t.Errorf("ID = %q, want a version 4 UUID", tt.event.GetID())
+			}
+			if got := tt.event.GetType(); got != tt.wantType {
+				t.Errorf("Type = %q, want %q", got, tt.wantType)
+			}
+			if got := tt.event.GetAggregateID(); got != tt.wantAggregate {
+				t.Errorf("AggregateID = %q, want %q", got, tt.wantAggregate)
+			}
+			if at := tt.event.GetOccurredAt(); at.IsZero() || time.Since(at) > time.Minute {
+				t.Errorf("OccurredAt = %v, want the time of creation", at)
+			}
+			if got := withoutBase(tt.event); !reflect.DeepEqual(got, tt.wantPayload) {
+				t.Errorf("payload = %+v, want %+v", got, tt.wantPayload)
+			}
+		})
+	}
+}
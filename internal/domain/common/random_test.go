@@ -0,0 +1,56 @@
+// domain/common/random_test.go
+package common
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNormFloat64(t *testing.T) {
+	tests := []struct {
+		name string
+		src  RandSource
+	}{
+		{"generator with its own normal", NewRandSource(7)},
+		{"Box-Muller fallback", uniformOnly{NewRandSource(7)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const n = 20000
+			var sum, sumSq float64
+			for i := 0; i < n; i++ {
+				v := NormFloat64(tt.src)
+				if math.IsNaN(v) || math.IsInf(v, 0) {
+					t.Fatalf("draw %d = %v", i, v)
+				}
+				sum += v
+				sumSq += v * v
+			}
+			mean := sum / n
+			variance := sumSq/n - mean*mean
+			if math.Abs(mean) > 0.05 {
+				t.Errorf("mean = %.3f, want about 0", mean)
+			}
+			if math.Abs(variance-1) > 0.05 {
+				t.Errorf("variance = %.3f, want about 1", variance)
+			}
+		})
+	}
+}
+
+func TestNormFloat64ScriptedZero(t *testing.T) {
+	// A scripted zero must not reach log(0)
+	v := NormFloat64(NewScriptedRand(nil, []float64{0}))
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		t.Errorf("NormFloat64 = %v, want a finite value", v)
+	}
+}
+
+// uniformOnly hides a source's NormFloat64 to force the fallback
+type uniformOnly struct {
+	src RandSource
+}
+
+func (u uniformOnly) Intn(n int) int   { return u.src.Intn(n) }
+func (u uniformOnly) Float64() float64 { return u.src.Float64() }
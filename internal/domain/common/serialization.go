@@ -0,0 +1,76 @@
+// domain/common/serialization.go
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// eventEnvelope wraps a serialized event with its type discriminator
+type eventEnvelope struct {
+	Type    EventType       `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// eventDecoders maps event types to their concrete decoders
+var eventDecoders = map[EventType]func([]byte) (DomainEvent, error){
+	EventMatchScheduled:  decodeEvent[MatchScheduledEvent],
+	EventMatchCompleted:  decodeEvent[MatchCompletedEvent],
+	EventGoalScored:      decodeEvent[GoalScoredEvent],
+	EventCardIssued:      decodeEvent[CardIssuedEvent],
+	EventPlayerInjured:   decodeEvent[PlayerInjuredEvent],
+	EventPlayerSuspended: decodeEvent[PlayerSuspendedEvent],
+	EventPlayerTrained:   decodeEvent[PlayerTrainedEvent],
+	EventLineupSet:       decodeEvent[LineupSetEvent],
+	EventSeasonStarted:   decodeEvent[SeasonStartedEvent],
+	EventSeasonCompleted: decodeEvent[SeasonCompletedEvent],
+}
+
+// decodeEvent unmarshals a payload into a concrete event type
+func decodeEvent[T DomainEvent](data []byte) (DomainEvent, error) {
+	var event T
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// MarshalEvent serializes an event along with its type
+func MarshalEvent(event DomainEvent) ([]byte, error) {
+	if event == nil {
+		return nil, fmt.Errorf("cannot marshal nil event")
+	}
+	if _, ok := eventDecoders[event.GetType()]; !ok {
+		return nil, fmt.Errorf("unknown event type %q", event.GetType())
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(eventEnvelope{
+		Type:    event.GetType(),
+		Payload: payload,
+	})
+}
+
+// UnmarshalEvent reconstructs the concrete event from serialized data
+func UnmarshalEvent(data []byte) (DomainEvent, error) {
+	var envelope eventEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("invalid event envelope: %w", err)
+	}
+
+	decode, ok := eventDecoders[envelope.Type]
+	if !ok {
+		return nil, fmt.Errorf("unknown event type %q", envelope.Type)
+	}
+
+	event, err := decode(envelope.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s payload: %w", envelope.Type, err)
+	}
+
+	return event, nil
+}
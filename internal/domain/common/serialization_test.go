@@ -0,0 +1,94 @@
+// domain/common/serialization_test.go
+package common
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEventRoundTrip(t *testing.T) {
+	scheduled := time.Date(2026, 8, 15, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		event DomainEvent
+	}{
+		{"match scheduled", NewMatchScheduledEvent("m1", "home", "away", scheduled)},
+		{"match completed", NewMatchCompletedEvent("m1", 2, 1, map[string]interface{}{"attendance": 41000.0})},
+		{"goal scored", NewGoalScoredEvent("m1", "p1", "t1", 63)},
+		{"card issued", NewCardIssuedEvent("m1", "p1", "t1", "yellow", 12)},
+		{"player injured", NewPlayerInjuredEvent("p1", "hamstring", 21)},
+		{"player suspended", NewPlayerSuspendedEvent("p1", 3, "red card")},
+		{"player trained", NewPlayerTrainedEvent("p1", "finishing", map[string]int{"finishing": 1})},
+		{"lineup set", NewLineupSetEvent("t1", "m1", []string{"p1", "p2"}, "4-3-3")},
+		{"season started", NewSeasonStartedEvent("s1", "l1", scheduled, []string{"t1", "t2"})},
+		{"season completed", NewSeasonCompletedEvent("s1", "l1", "t1", []string{"t1", "t2"}, []string{"t3"}, []string{"t2"})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := MarshalEvent(tt.event)
+			if err != nil {
+				t.Fatalf("MarshalEvent: %v", err)
+			}
+			got, err := UnmarshalEvent(data)
+			if err != nil {
+				t.Fatalf("UnmarshalEvent: %v", err)
+			}
+
+			if reflect.TypeOf(got) != reflect.TypeOf(tt.event) {
+				t.Fatalf("decoded %T, want %T", got, tt.event)
+			}
+			if got.GetID() != tt.event.GetID() || got.GetAggregateID() != tt.event.GetAggregateID() {
+				t.Errorf("decoded base %+v, want %+v", got, tt.event)
+			}
+			if !got.GetOccurredAt().Equal(tt.event.GetOccurredAt()) {
+				t.Errorf("OccurredAt = %v, want %v", got.GetOccurredAt(), tt.event.GetOccurredAt())
+			}
+		})
+	}
+}
+
+func TestMarshalEventErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		event DomainEvent
+		want  string
+	}{
+		{"nil event", nil, "nil event"},
+		{"unregistered type", NewBaseEvent(EventMatchStarted, "m1"), "unknown event type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := MarshalEvent(tt.event)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("MarshalEvent = %v, want error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshalEventErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"not JSON", `not json`, "invalid event envelope"},
+		{"unknown type", `{"type":"match.started","payload":{}}`, "unknown event type"},
+		{"missing type", `{"payload":{}}`, "unknown event type"},
+		{"bad payload", `{"type":"match.goal_scored","payload":{"Minute":"late"}}`, "invalid match.goal_scored payload"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := UnmarshalEvent([]byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("UnmarshalEvent = %v, want error containing %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+// domain/common/store.go
+package common
+
+import (
+	"sort"
+	"sync"
+)
+
+// EventStore keeps domain events in memory for replay and querying
+type EventStore struct {
+	mu     sync.RWMutex
+	events []DomainEvent
+}
+
+// NewEventStore creates an empty event store
+func NewEventStore() *EventStore {
+	return &EventStore{}
+}
+
+// Append records an event
+func (s *EventStore) Append(event DomainEvent) {
+	if event == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, event)
+}
+
+// EventsFor returns an aggregate's events ordered by occurrence
+func (s *EventStore) EventsFor(aggregateID string) []DomainEvent {
+	return s.filter(func(e DomainEvent) bool {
+		return e.GetAggregateID() == aggregateID
+	})
+}
+
+// EventsByType returns all events of a type ordered by occurrence
+func (s *EventStore) EventsByType(eventType EventType) []DomainEvent {
+	return s.filter(func(e DomainEvent) bool {
+		return e.GetType() == eventType
+	})
+}
+
+// Len returns the number of stored events
+func (s *EventStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.events)
+}
+
+// filter collects matching events, keeping append order for equal timestamps
+func (s *EventStore) filter(match func(DomainEvent) bool) []DomainEvent {
+	s.mu.RLock()
+	result := []DomainEvent{}
+	for _, e := range s.events {
+		if match(e) {
+			result = append(result, e)
+		}
+	}
+	s.mu.RUnlock()
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].GetOccurredAt().Before(result[j].GetOccurredAt())
+	})
+
+	return result
+}
@@ -0,0 +1,71 @@
+// domain/common/store_test.go
+package common
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// eventAt creates a base event with a fixed id and occurrence time
+func eventAt(id string, eventType EventType, aggregateID string, minute int) BaseEvent {
+	return BaseEvent{
+		ID:          id,
+		Type:        eventType,
+		OccurredAt:  time.Date(2026, 8, 15, 15, minute, 0, 0, time.UTC),
+		AggregateID: aggregateID,
+	}
+}
+
+func TestEventStoreQueries(t *testing.T) {
+	store := NewEventStore()
+	store.Append(eventAt("e1", EventGoalScored, "m1", 30))
+	store.Append(eventAt("e2", EventCardIssued, "m1", 10))
+	store.Append(eventAt("e3", EventGoalScored, "m2", 20))
+	store.Append(eventAt("e4", EventGoalScored, "m1", 30)) // ties with e1
+	store.Append(nil)
+
+	ids := func(events []DomainEvent) []string {
+		out := []string{}
+		for _, e := range events {
+			out = append(out, e.GetID())
+		}
+		return out
+	}
+
+	tests := []struct {
+		name string
+		got  []DomainEvent
+		want []string
+	}{
+		{"by aggregate orders by time, ties by append", store.EventsFor("m1"), []string{"e2", "e1", "e4"}},
+		{"by other aggregate", store.EventsFor("m2"), []string{"e3"}},
+		{"unknown aggregate", store.EventsFor("m9"), []string{}},
+		{"by type", store.EventsByType(EventGoalScored), []string{"e3", "e1", "e4"}},
+		{"type never appended", store.EventsByType(EventSeasonStarted), []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ids(tt.got); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := store.Len(); got != 4 {
+		t.Errorf("Len() = %d, want 4 (nil events are ignored)", got)
+	}
+}
+
+func TestEventStoreResultsAreCopies(t *testing.T) {
+	store := NewEventStore()
+	store.Append(eventAt("e1", EventGoalScored, "m1", 1))
+
+	events := store.EventsFor("m1")
+	events[0] = eventAt("changed", EventGoalScored, "m1", 1)
+
+	if got := store.EventsFor("m1")[0].GetID(); got != "e1" {
+		t.Errorf("stored event = %s after editing a query result, want e1", got)
+	}
+}
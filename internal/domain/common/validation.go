@@ -0,0 +1,39 @@
+// domain/common/validation.go
+package common
+
+import (
+	"strings"
+)
+
+// ValidationErrors aggregates multiple domain errors found in one pass
+type ValidationErrors []DomainError
+
+func (v ValidationErrors) Error() string {
+	messages := make([]string, len(v))
+	for i, e := range v {
+		messages[i] = e.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap exposes each error so errors.Is matches any of them
+func (v ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(v))
+	for i, e := range v {
+		errs[i] = e
+	}
+	return errs
+}
+
+// Add appends a domain error
+func (v *ValidationErrors) Add(err DomainError) {
+	*v = append(*v, err)
+}
+
+// ErrOrNil returns nil when no errors were collected
+func (v ValidationErrors) ErrOrNil() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return v
+}
@@ -0,0 +1,69 @@
+// domain/common/validation_test.go
+package common
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidationErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		errs     []DomainError
+		wantNil  bool
+		wantMsg  string
+		matches  []error
+		excludes []error
+	}{
+		{
+			name:    "none collected",
+			wantNil: true,
+		},
+		{
+			name:     "single error",
+			errs:     []DomainError{ErrInvalidFormation},
+			wantMsg:  "[INVALID_FORMATION] Invalid formation",
+			matches:  []error{ErrInvalidFormation},
+			excludes: []error{ErrInsufficientPlayers},
+		},
+		{
+			name:    "several errors",
+			errs:    []DomainError{ErrInsufficientPlayers, PlayerNotFound("p1")},
+			wantMsg: "[INSUFFICIENT_PLAYERS] Not enough players for lineup; [PLAYER_NOT_FOUND] Player not found: p1",
+			matches: []error{ErrInsufficientPlayers, ErrPlayerNotFound},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v ValidationErrors
+			for _, e := range tt.errs {
+				v.Add(e)
+			}
+
+			err := v.ErrOrNil()
+			if tt.wantNil {
+				if err != nil {
+					t.Errorf("ErrOrNil() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("ErrOrNil() = nil, want an error")
+			}
+			if got := err.Error(); got != tt.wantMsg {
+				t.Errorf("Error() = %q, want %q", got, tt.wantMsg)
+			}
+			for _, target := range tt.matches {
+				if !errors.Is(err, target) {
+					t.Errorf("errors.Is(err, %v) = false, want true", target)
+				}
+			}
+			for _, target := range tt.excludes {
+				if errors.Is(err, target) {
+					t.Errorf("errors.Is(err, %v) = true, want false", target)
+				}
+			}
+		})
+	}
+}
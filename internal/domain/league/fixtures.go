@@ -0,0 +1,101 @@
+// domain/league/fixtures.go
+package league
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// Fixture represents a scheduled league match
+type Fixture struct {
+	ID         string
+	Round      int
+	HomeTeamID team.TeamID
+	AwayTeamID team.TeamID
+	Date       time.Time
+	Played     bool
+	HomeScore  int
+	AwayScore  int
+}
+
+// Involves checks if a team plays in the fixture
+func (f Fixture) Involves(teamID team.TeamID) bool {
+	return f.HomeTeamID == teamID || f.AwayTeamID == teamID
+}
+
+// ScheduledMatch describes the fixture for squad rotation planning
+func (f Fixture) ScheduledMatch() team.ScheduledMatch {
+	return team.ScheduledMatch{ID: f.ID, Date: f.Date}
+}
+
+// GenerateFixtures creates a balanced round-robin schedule using the circle
+// method. With an odd number of teams one team sits out (a bye) each round.
+func GenerateFixtures(teams []team.TeamID, doubleRound bool) ([]Fixture, error) {
+	if len(teams) < 2 {
+		return nil, fmt.Errorf("at least 2 teams are required, got %d", len(teams))
+	}
+
+	seen := make(map[team.TeamID]bool)
+	for _, id := range teams {
+		if id == "" {
+			return nil, fmt.Errorf("team ID cannot be empty")
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("team %s listed more than once", id)
+		}
+		seen[id] = true
+	}
+
+	// Pad with a bye so every round pairs everyone
+	rotation := append([]team.TeamID{}, teams...)
+	if len(rotation)%2 == 1 {
+		rotation = append(rotation, "")
+	}
+
+	n := len(rotation)
+	rounds := n - 1
+	fixtures := []Fixture{}
+
+	for round := 0; round < rounds; round++ {
+		for i := 0; i < n/2; i++ {
+			home, away := rotation[i], rotation[n-1-i]
+			if home == "" || away == "" {
+				continue // Bye
+			}
+
+			// Alternate venues so no team is always at home
+			if (round+i)%2 == 1 {
+				home, away = away, home
+			}
+
+			fixtures = append(fixtures, newFixture(round+1, home, away))
+		}
+
+		// Keep the first team fixed and rotate the rest clockwise
+		last := rotation[n-1]
+		copy(rotation[2:], rotation[1:n-1])
+		rotation[1] = last
+	}
+
+	if doubleRound {
+		firstHalf := len(fixtures)
+		for i := 0; i < firstHalf; i++ {
+			f := fixtures[i]
+			fixtures = append(fixtures, newFixture(f.Round+rounds, f.AwayTeamID, f.HomeTeamID))
+		}
+	}
+
+	return fixtures, nil
+}
+
+// newFixture creates an unplayed fixture
+func newFixture(round int, home, away team.TeamID) Fixture {
+	return Fixture{
+		ID:         fmt.Sprintf("R%02d-%s-%s", round, home, away),
+		Round:      round,
+		HomeTeamID: home,
+		AwayTeamID: away,
+	}
+}
@@ -0,0 +1,145 @@
+// domain/league/fixtures_test.go
+package league
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// testTeamIDs returns n team IDs
+func testTeamIDs(n int) []team.TeamID {
+	ids := make([]team.TeamID, n)
+	for i := range ids {
+		ids[i] = team.TeamID(fmt.Sprintf("T%02d", i+1))
+	}
+	return ids
+}
+
+// pairing identifies two teams regardless of venue
+func pairing(a, b team.TeamID) [2]team.TeamID {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]team.TeamID{a, b}
+}
+
+func TestGenerateFixturesSingleRound(t *testing.T) {
+	for _, n := range []int{2, 5, 6, 7, 20} {
+		t.Run(fmt.Sprintf("%d teams", n), func(t *testing.T) {
+			teams := testTeamIDs(n)
+			fixtures, err := GenerateFixtures(teams, false)
+			if err != nil {
+				t.Fatalf("GenerateFixtures: %v", err)
+			}
+
+			rounds := n - 1
+			if n%2 == 1 {
+				rounds = n
+			}
+
+			meetings := make(map[[2]team.TeamID]int)
+			playing := make(map[int]map[team.TeamID]bool)
+			for _, f := range fixtures {
+				if f.HomeTeamID == f.AwayTeamID {
+					t.Errorf("%s plays itself", f.ID)
+				}
+				if f.Round < 1 || f.Round > rounds {
+					t.Errorf("%s in round %d, want 1 to %d", f.ID, f.Round, rounds)
+				}
+				meetings[pairing(f.HomeTeamID, f.AwayTeamID)]++
+
+				if playing[f.Round] == nil {
+					playing[f.Round] = make(map[team.TeamID]bool)
+				}
+				for _, id := range []team.TeamID{f.HomeTeamID, f.AwayTeamID} {
+					if playing[f.Round][id] {
+						t.Errorf("%s plays twice in round %d", id, f.Round)
+					}
+					playing[f.Round][id] = true
+				}
+			}
+
+			for i, a := range teams {
+				for _, b := range teams[i+1:] {
+					if got := meetings[pairing(a, b)]; got != 1 {
+						t.Errorf("%s and %s meet %d times, want once", a, b, got)
+					}
+				}
+			}
+
+			// Everyone plays every round, bar one bye each with an odd count
+			byes := make(map[team.TeamID]int)
+			for round := 1; round <= rounds; round++ {
+				sitting := 0
+				for _, id := range teams {
+					if !playing[round][id] {
+						byes[id]++
+						sitting++
+					}
+				}
+				if sitting != n%2 {
+					t.Errorf("round %d: %d teams sit out, want %d", round, sitting, n%2)
+				}
+			}
+			for _, id := range teams {
+				if byes[id] != n%2 {
+					t.Errorf("%s has %d byes, want %d", id, byes[id], n%2)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateFixturesDoubleRoundMirrors(t *testing.T) {
+	for _, n := range []int{4, 5} {
+		t.Run(fmt.Sprintf("%d teams", n), func(t *testing.T) {
+			single, err := GenerateFixtures(testTeamIDs(n), false)
+			if err != nil {
+				t.Fatalf("GenerateFixtures: %v", err)
+			}
+			double, err := GenerateFixtures(testTeamIDs(n), true)
+			if err != nil {
+				t.Fatalf("GenerateFixtures: %v", err)
+			}
+
+			if len(double) != 2*len(single) {
+				t.Fatalf("%d fixtures over two rounds, want %d", len(double), 2*len(single))
+			}
+
+			rounds := single[len(single)-1].Round
+			for i, first := range single {
+				second := double[len(single)+i]
+				if double[i] != first {
+					t.Errorf("first half fixture %d = %+v, want %+v", i, double[i], first)
+				}
+				if second.HomeTeamID != first.AwayTeamID || second.AwayTeamID != first.HomeTeamID {
+					t.Errorf("return of %s is %s, want venues swapped", first.ID, second.ID)
+				}
+				if second.Round != first.Round+rounds {
+					t.Errorf("return of %s in round %d, want %d", first.ID, second.Round, first.Round+rounds)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateFixturesRejectsBadTeams(t *testing.T) {
+	tests := []struct {
+		name  string
+		teams []team.TeamID
+	}{
+		{"too few", []team.TeamID{"A"}},
+		{"empty ID", []team.TeamID{"A", ""}},
+		{"duplicate", []team.TeamID{"A", "B", "A"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := GenerateFixtures(tt.teams, false); err == nil {
+				t.Error("GenerateFixtures() succeeded, want an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+// domain/league/leaders.go
+package league
+
+import (
+	"sort"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// PlayerRef identifies a player on a leaderboard with the stat ranked
+type PlayerRef struct {
+	PlayerID player.PlayerID
+	TeamID   team.TeamID
+	Name     string
+	Value    float64
+}
+
+// SeasonLeaders ranks every player in a league on their current season
+type SeasonLeaders struct {
+	entries []leaderEntry
+}
+
+// leaderEntry pairs a player with their club
+type leaderEntry struct {
+	teamID team.TeamID
+	player player.Player
+}
+
+// NewSeasonLeaders collects the players of the league's teams for ranking
+func NewSeasonLeaders(teams []*team.Team) *SeasonLeaders {
+	sl := &SeasonLeaders{}
+	for _, t := range teams {
+		for _, p := range t.Squad() {
+			sl.entries = append(sl.entries, leaderEntry{teamID: t.ID, player: p})
+		}
+	}
+	return sl
+}
+
+// TopScorers returns up to n leading goalscorers. Ties go to more assists,
+// then fewer matches played.
+func (sl *SeasonLeaders) TopScorers(n int) []PlayerRef {
+	return sl.top(n, func(s player.SeasonStats) float64 { return float64(s.Goals) },
+		func(a, b player.SeasonStats) bool {
+			if a.Assists != b.Assists {
+				return a.Assists > b.Assists
+			}
+			return a.Matches < b.Matches
+		})
+}
+
+// TopAssists returns up to n leading providers. Ties go to more goals, then
+// fewer matches played.
+func (sl *SeasonLeaders) TopAssists(n int) []PlayerRef {
+	return sl.top(n, func(s player.SeasonStats) float64 { return float64(s.Assists) },
+		func(a, b player.SeasonStats) bool {
+			if a.Goals != b.Goals {
+				return a.Goals > b.Goals
+			}
+			return a.Matches < b.Matches
+		})
+}
+
+// BestRatedXI picks the highest average rated players in their natural
+// positions for the default formation. Only players who have featured in
+// at least half as many matches as the busiest player are considered.
+// The best rated starter captains the side.
+func (sl *SeasonLeaders) BestRatedXI() team.Lineup {
+	formation := team.FormationDefault
+	lineup := team.Lineup{
+		Formation:   formation,
+		Starters:    []player.PlayerID{},
+		Positions:   []player.Position{},
+		Substitutes: []player.PlayerID{},
+	}
+
+	mostMatches := 0
+	for _, e := range sl.entries {
+		if m := e.player.CareerStats.CurrentSeason.Matches; m > mostMatches {
+			mostMatches = m
+		}
+	}
+	if mostMatches == 0 {
+		return lineup
+	}
+	minMatches := (mostMatches + 1) / 2
+
+	eligible := []leaderEntry{}
+	for _, e := range sl.entries {
+		if e.player.CareerStats.CurrentSeason.Matches >= minMatches {
+			eligible = append(eligible, e)
+		}
+	}
+	sort.SliceStable(eligible, func(i, j int) bool {
+		a, b := eligible[i].player.CareerStats.CurrentSeason, eligible[j].player.CareerStats.CurrentSeason
+		if a.AverageRating != b.AverageRating {
+			return a.AverageRating > b.AverageRating
+		}
+		if a.Matches != b.Matches {
+			return a.Matches > b.Matches
+		}
+		return eligible[i].player.ID < eligible[j].player.ID
+	})
+
+	requirements := formation.GetPositionRequirements()
+	for _, pos := range []player.Position{
+		player.PositionGK,
+		player.PositionDEF,
+		player.PositionMID,
+		player.PositionFWD,
+	} {
+		picked := 0
+		for _, e := range eligible {
+			if picked == requirements[pos] {
+				break
+			}
+			if e.player.Position == pos {
+				lineup.Starters = append(lineup.Starters, e.player.ID)
+				lineup.Positions = append(lineup.Positions, pos)
+				picked++
+			}
+		}
+	}
+
+	// Eligible players are in rating order, so the first starter found leads
+	for _, e := range eligible {
+		for _, id := range lineup.Starters {
+			if id == e.player.ID {
+				lineup.Captain = id
+				return lineup
+			}
+		}
+	}
+
+	return lineup
+}
+
+// top ranks players by a season stat, breaking ties with the given rule and
+// then by player ID. Players with nothing to show are left out.
+func (sl *SeasonLeaders) top(n int, stat func(player.SeasonStats) float64, tieBreak func(a, b player.SeasonStats) bool) []PlayerRef {
+	if n <= 0 {
+		return []PlayerRef{}
+	}
+
+	ranked := []leaderEntry{}
+	for _, e := range sl.entries {
+		if stat(e.player.CareerStats.CurrentSeason) > 0 {
+			ranked = append(ranked, e)
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i].player.CareerStats.CurrentSeason, ranked[j].player.CareerStats.CurrentSeason
+		if stat(a) != stat(b) {
+			return stat(a) > stat(b)
+		}
+		if tieBreak(a, b) != tieBreak(b, a) {
+			return tieBreak(a, b)
+		}
+		return ranked[i].player.ID < ranked[j].player.ID
+	})
+
+	if len(ranked) > n {
+		ranked = ranked[:n]
+	}
+
+	refs := make([]PlayerRef, len(ranked))
+	for i, e := range ranked {
+		refs[i] = PlayerRef{
+			PlayerID: e.player.ID,
+			TeamID:   e.teamID,
+			Name:     e.player.FullName(),
+			Value:    stat(e.player.CareerStats.CurrentSeason),
+		}
+	}
+	return refs
+}
@@ -0,0 +1,158 @@
+// domain/league/leaders_test.go
+package league
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// leaderSpec describes a player's season so far
+type leaderSpec struct {
+	id      player.PlayerID
+	pos     player.Position
+	matches int
+	rating  float64
+	goals   int
+	assists int
+}
+
+// newLeadersLeague builds a two-club league with ties on goals and assists,
+// a regular in every position and a couple of stars short of matches
+func newLeadersLeague(t *testing.T) *SeasonLeaders {
+	t.Helper()
+	clubs := []struct {
+		id      team.TeamID
+		players []leaderSpec
+	}{
+		{"A", []leaderSpec{
+			{"a-gk", player.PositionGK, 20, 7.2, 0, 0},
+			{"a-def0", player.PositionDEF, 20, 6.0, 0, 0},
+			{"a-def1", player.PositionDEF, 20, 6.2, 0, 0},
+			{"a-def2", player.PositionDEF, 20, 6.4, 0, 0},
+			{"a-def3", player.PositionDEF, 20, 6.6, 1, 0},
+			{"a-def4", player.PositionDEF, 20, 6.8, 0, 1},
+			{"a-mid1", player.PositionMID, 20, 8.0, 3, 9},
+			{"a-mid2", player.PositionMID, 20, 6.5, 0, 0},
+			{"a-fwd1", player.PositionFWD, 20, 7.5, 10, 2},
+			{"a-fwd2", player.PositionFWD, 22, 7.0, 10, 5},
+		}},
+		{"B", []leaderSpec{
+			{"b-gk", player.PositionGK, 20, 7.5, 0, 0},
+			{"b-def", player.PositionDEF, 20, 7.1, 0, 0},
+			{"b-mid", player.PositionMID, 25, 9.5, 0, 9},
+			{"b-mid2", player.PositionMID, 13, 7.0, 0, 0}, // Just enough matches
+			{"b-mid3", player.PositionMID, 12, 9.0, 0, 0}, // One match short
+			{"b-star", player.PositionFWD, 5, 9.9, 4, 0},  // Barely played
+			{"b-fwd", player.PositionFWD, 20, 6.9, 10, 5},
+		}},
+	}
+
+	teams := []*team.Team{}
+	for _, club := range clubs {
+		tm := team.NewTeam(club.id, "Club "+string(club.id), team.Stadium{Name: "Ground", Capacity: 20000})
+		for _, s := range club.players {
+			p := player.NewPlayer(s.id, "Test", string(s.id), s.pos, time.Now().AddDate(-25, 0, -1))
+			p.CareerStats.CurrentSeason = player.SeasonStats{
+				Matches:       s.matches,
+				Goals:         s.goals,
+				Assists:       s.assists,
+				AverageRating: s.rating,
+			}
+			if err := tm.AddPlayer(*p); err != nil {
+				t.Fatalf("AddPlayer(%s): %v", s.id, err)
+			}
+		}
+		teams = append(teams, tm)
+	}
+	return NewSeasonLeaders(teams)
+}
+
+// leaderIDs lists the players on a leaderboard in order
+func leaderIDs(refs []PlayerRef) []player.PlayerID {
+	ids := []player.PlayerID{}
+	for _, r := range refs {
+		ids = append(ids, r.PlayerID)
+	}
+	return ids
+}
+
+func TestSeasonLeaderboards(t *testing.T) {
+	sl := newLeadersLeague(t)
+
+	tests := []struct {
+		name  string
+		board func(n int) []PlayerRef
+		n     int
+		want  []player.PlayerID
+	}{
+		{"scorers level on goals split by assists then matches", sl.TopScorers, 3,
+			[]player.PlayerID{"b-fwd", "a-fwd2", "a-fwd1"}},
+		{"scorers leave out players without a goal", sl.TopScorers, 20,
+			[]player.PlayerID{"b-fwd", "a-fwd2", "a-fwd1", "b-star", "a-mid1", "a-def3"}},
+		{"assists level split by goals then matches", sl.TopAssists, 4,
+			[]player.PlayerID{"a-mid1", "b-mid", "b-fwd", "a-fwd2"}},
+		{"capped at n", sl.TopAssists, 1, []player.PlayerID{"a-mid1"}},
+		{"no places", sl.TopScorers, 0, []player.PlayerID{}},
+		{"negative places", sl.TopAssists, -1, []player.PlayerID{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := leaderIDs(tt.board(tt.n)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("leaders = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopScorersReportsClubAndTally(t *testing.T) {
+	top := newLeadersLeague(t).TopScorers(1)
+	if len(top) != 1 {
+		t.Fatalf("TopScorers(1) returned %d players", len(top))
+	}
+	want := PlayerRef{PlayerID: "b-fwd", TeamID: "B", Name: "Test b-fwd", Value: 10}
+	if top[0] != want {
+		t.Errorf("TopScorers(1) = %+v, want %+v", top[0], want)
+	}
+}
+
+func TestBestRatedXI(t *testing.T) {
+	lineup := newLeadersLeague(t).BestRatedXI()
+
+	wantStarters := []player.PlayerID{
+		"b-gk",
+		"b-def", "a-def4", "a-def3", "a-def2",
+		"b-mid", "a-mid1", "b-mid2", "a-mid2",
+		"a-fwd1", "a-fwd2",
+	}
+	if !reflect.DeepEqual(lineup.Starters, wantStarters) {
+		t.Errorf("Starters = %v, want %v", lineup.Starters, wantStarters)
+	}
+	if lineup.Formation != team.FormationDefault {
+		t.Errorf("Formation = %s, want %s", lineup.Formation, team.FormationDefault)
+	}
+	if lineup.Captain != "b-mid" {
+		t.Errorf("Captain = %s, want the best rated starter b-mid", lineup.Captain)
+	}
+	counts := map[player.Position]int{}
+	for _, pos := range lineup.Positions {
+		counts[pos]++
+	}
+	for pos, want := range lineup.Formation.GetPositionRequirements() {
+		if counts[pos] != want {
+			t.Errorf("%d players at %s, want %d", counts[pos], pos, want)
+		}
+	}
+}
+
+func TestBestRatedXIBeforeAnyMatches(t *testing.T) {
+	tm := team.NewTeam("A", "Club A", team.Stadium{Name: "Ground", Capacity: 20000})
+	lineup := NewSeasonLeaders([]*team.Team{tm}).BestRatedXI()
+	if len(lineup.Starters) != 0 || lineup.Captain != "" {
+		t.Errorf("BestRatedXI() = %+v, want an empty lineup", lineup)
+	}
+}
@@ -0,0 +1,44 @@
+// domain/league/league.go
+package league
+
+import (
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// LeagueID represents a unique league identifier
+type LeagueID string
+
+// League represents a competition and its member teams
+type League struct {
+	ID      LeagueID
+	Name    string
+	Country string
+	Teams   []team.TeamID
+
+	// Metadata
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewLeague creates a new league
+func NewLeague(id LeagueID, name string, teams []team.TeamID) *League {
+	return &League{
+		ID:        id,
+		Name:      name,
+		Teams:     append([]team.TeamID{}, teams...),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// HasTeam checks if a team belongs to the league
+func (l *League) HasTeam(teamID team.TeamID) bool {
+	for _, id := range l.Teams {
+		if id == teamID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+// domain/league/promotion.go
+package league
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// ApplyPromotionRelegation picks the top and bottom teams by final position.
+// Requests larger than the league are clamped so no team is both promoted
+// and relegated; promotion takes precedence.
+func ApplyPromotionRelegation(table LeagueTable, up, down int) (promoted, relegated []team.TeamID) {
+	standings := table.Standings()
+	n := len(standings)
+
+	up = clamp(up, 0, n)
+	down = clamp(down, 0, n-up)
+
+	promoted = make([]team.TeamID, 0, up)
+	for _, st := range standings[:up] {
+		promoted = append(promoted, st.TeamID)
+	}
+
+	relegated = make([]team.TeamID, 0, down)
+	for _, st := range standings[n-down:] {
+		relegated = append(relegated, st.TeamID)
+	}
+
+	return promoted, relegated
+}
+
+// CompletedEvent summarizes the final table for a season completed event
+func (s *Season) CompletedEvent(up, down int) common.SeasonCompletedEvent {
+	promoted, relegated := ApplyPromotionRelegation(*s.Table, up, down)
+
+	standings := []string{}
+	for _, st := range s.Table.Standings() {
+		standings = append(standings, string(st.TeamID))
+	}
+
+	champion := ""
+	if len(standings) > 0 {
+		champion = standings[0]
+	}
+
+	return common.NewSeasonCompletedEvent(s.ID, string(s.LeagueID), champion, standings, toStrings(promoted), toStrings(relegated))
+}
+
+// toStrings converts team IDs for use in events
+func toStrings(ids []team.TeamID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = string(id)
+	}
+	return out
+}
+
+// clamp bounds a value to a range
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
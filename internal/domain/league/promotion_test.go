@@ -0,0 +1,67 @@
+// domain/league/promotion_test.go
+package league
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// newRankedTable builds a table finishing A, B, C, D, each team having
+// beaten everyone below it
+func newRankedTable(t *testing.T) *LeagueTable {
+	t.Helper()
+	ids := []team.TeamID{"A", "B", "C", "D"}
+	lt := NewLeagueTable(ids)
+	for i, home := range ids {
+		for _, away := range ids[i+1:] {
+			if err := lt.RecordScore(home, away, 2, 0); err != nil {
+				t.Fatalf("RecordScore(%s v %s): %v", home, away, err)
+			}
+		}
+	}
+	return lt
+}
+
+func TestApplyPromotionRelegation(t *testing.T) {
+	tests := []struct {
+		name          string
+		table         *LeagueTable
+		up, down      int
+		wantPromoted  []team.TeamID
+		wantRelegated []team.TeamID
+	}{
+		{"normal split", newRankedTable(t), 1, 1, []team.TeamID{"A"}, []team.TeamID{"D"}},
+		{"none either way", newRankedTable(t), 0, 0, []team.TeamID{}, []team.TeamID{}},
+		// B and A are level on points, goal difference and goals; B won
+		// the meeting between them
+		{"split decided by tie-break", newTieBreakTable(t, DefaultTieBreakConfig()), 2, 2, []team.TeamID{"C", "B"}, []team.TeamID{"A", "D"}},
+		{"split without head-to-head", newTieBreakTable(t, TieBreakConfig{Order: []TieBreaker{TieBreakGoalDifference}}), 2, 2, []team.TeamID{"C", "A"}, []team.TeamID{"B", "D"}},
+		{"more places than teams", newRankedTable(t), 3, 3, []team.TeamID{"A", "B", "C"}, []team.TeamID{"D"}},
+		{"promotion fills the league", newRankedTable(t), 6, 2, []team.TeamID{"A", "B", "C", "D"}, []team.TeamID{}},
+		{"negative requests", newRankedTable(t), -1, -1, []team.TeamID{}, []team.TeamID{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			promoted, relegated := ApplyPromotionRelegation(*tt.table, tt.up, tt.down)
+			if !reflect.DeepEqual(promoted, tt.wantPromoted) {
+				t.Errorf("promoted = %v, want %v", promoted, tt.wantPromoted)
+			}
+			if !reflect.DeepEqual(relegated, tt.wantRelegated) {
+				t.Errorf("relegated = %v, want %v", relegated, tt.wantRelegated)
+			}
+
+			up := make(map[team.TeamID]bool)
+			for _, id := range promoted {
+				up[id] = true
+			}
+			for _, id := range relegated {
+				if up[id] {
+					t.Errorf("%s is both promoted and relegated", id)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// domain/league/schedule.go
+package league
+
+import (
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// DefaultMinRestDays is the number of full days a team rests between matches
+const DefaultMinRestDays = 2
+
+// ScheduleFixture adds a fixture unless it double-books a team or leaves
+// either side without enough rest
+func (s *Season) ScheduleFixture(f Fixture) error {
+	if f.HomeTeamID == f.AwayTeamID {
+		return common.ErrFixtureConflict.WithDetails(map[string]interface{}{
+			"fixture_id": f.ID,
+			"reason":     "team cannot play itself",
+		})
+	}
+	for _, existing := range s.Fixtures {
+		if existing.ID == f.ID {
+			return common.ErrFixtureConflict.WithDetails(map[string]interface{}{
+				"fixture_id": f.ID,
+				"reason":     "fixture already scheduled",
+			})
+		}
+	}
+
+	if err := checkFixtureConflict(s.Fixtures, f, s.MinRestDays); err != nil {
+		return err
+	}
+
+	s.Fixtures = append(s.Fixtures, f)
+	return nil
+}
+
+// ValidateSchedule reports every conflict in a fixture list using the
+// default minimum rest
+func ValidateSchedule(fixtures []Fixture) []error {
+	errs := []error{}
+	for i, f := range fixtures {
+		if err := checkFixtureConflict(fixtures[:i], f, DefaultMinRestDays); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// checkFixtureConflict compares a fixture against already scheduled ones.
+// Fixtures without a date are not yet scheduled and never conflict.
+func checkFixtureConflict(scheduled []Fixture, f Fixture, minRestDays int) error {
+	if f.Date.IsZero() {
+		return nil
+	}
+
+	for _, existing := range scheduled {
+		if existing.Date.IsZero() {
+			continue
+		}
+
+		for _, teamID := range []team.TeamID{f.HomeTeamID, f.AwayTeamID} {
+			if !existing.Involves(teamID) {
+				continue
+			}
+
+			gap := daysBetween(existing.Date, f.Date)
+			if gap == 0 {
+				return common.ErrFixtureConflict.WithDetails(map[string]interface{}{
+					"fixture_id":  f.ID,
+					"conflict_id": existing.ID,
+					"team_id":     string(teamID),
+					"reason":      "team already plays on this date",
+				})
+			}
+			if gap <= minRestDays {
+				return common.ErrFixtureConflict.WithDetails(map[string]interface{}{
+					"fixture_id":  f.ID,
+					"conflict_id": existing.ID,
+					"team_id":     string(teamID),
+					"reason":      "insufficient rest between matches",
+					"rest_days":   gap - 1,
+				})
+			}
+		}
+	}
+
+	return nil
+}
+
+// daysBetween counts calendar days between two dates, ignoring order
+func daysBetween(a, b time.Time) int {
+	a = time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	b = time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+
+	days := int(b.Sub(a).Hours() / 24)
+	if days < 0 {
+		days = -days
+	}
+	return days
+}
@@ -0,0 +1,113 @@
+// domain/league/schedule_test.go
+package league
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// datedFixture creates a fixture played the given number of days into
+// the season
+func datedFixture(id string, home, away team.TeamID, day int) Fixture {
+	return Fixture{
+		ID:         id,
+		HomeTeamID: home,
+		AwayTeamID: away,
+		Date:       time.Date(2026, 8, 1, 15, 0, 0, 0, time.UTC).AddDate(0, 0, day),
+	}
+}
+
+func TestScheduleFixture(t *testing.T) {
+	tests := []struct {
+		name       string
+		fixture    Fixture
+		wantReason string // Empty when the fixture should be accepted
+	}{
+		{"clear of other matches", datedFixture("f2", "A", "C", 7), ""},
+		{"other teams on the same date", datedFixture("f2", "C", "D", 0), ""},
+		{"just enough rest", datedFixture("f2", "C", "A", 3), ""},
+		{"same team on the same date", datedFixture("f2", "C", "B", 0), "team already plays on this date"},
+		{"inside the minimum rest", datedFixture("f2", "A", "C", 2), "insufficient rest between matches"},
+		{"team playing itself", datedFixture("f2", "C", "C", 7), "team cannot play itself"},
+		{"already scheduled", datedFixture("f1", "C", "D", 7), "fixture already scheduled"},
+		{"undated", Fixture{ID: "f2", HomeTeamID: "A", AwayTeamID: "C"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Season{MinRestDays: DefaultMinRestDays}
+			if err := s.ScheduleFixture(datedFixture("f1", "A", "B", 0)); err != nil {
+				t.Fatalf("first fixture: %v", err)
+			}
+
+			err := s.ScheduleFixture(tt.fixture)
+			if tt.wantReason == "" {
+				if err != nil {
+					t.Fatalf("ScheduleFixture() = %v, want nil", err)
+				}
+				if len(s.Fixtures) != 2 {
+					t.Errorf("%d fixtures scheduled, want 2", len(s.Fixtures))
+				}
+				return
+			}
+
+			var domainErr common.DomainError
+			if !errors.As(err, &domainErr) || !errors.Is(err, common.ErrFixtureConflict) {
+				t.Fatalf("ScheduleFixture() = %v, want ErrFixtureConflict", err)
+			}
+			if domainErr.Details["reason"] != tt.wantReason {
+				t.Errorf("reason = %v, want %q", domainErr.Details["reason"], tt.wantReason)
+			}
+			if len(s.Fixtures) != 1 {
+				t.Errorf("%d fixtures scheduled after a conflict, want 1", len(s.Fixtures))
+			}
+		})
+	}
+}
+
+func TestValidateSchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		fixtures []Fixture
+		wantErrs int
+	}{
+		{
+			"clean schedule",
+			[]Fixture{
+				datedFixture("f1", "A", "B", 0),
+				datedFixture("f2", "C", "D", 0),
+				datedFixture("f3", "A", "C", 7),
+				datedFixture("f4", "B", "D", 7),
+			},
+			0,
+		},
+		{
+			"double booked and short of rest",
+			[]Fixture{
+				datedFixture("f1", "A", "B", 0),
+				datedFixture("f2", "B", "C", 0),
+				datedFixture("f3", "C", "D", 1),
+				datedFixture("f4", "A", "D", 14),
+			},
+			2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateSchedule(tt.fixtures)
+			if len(errs) != tt.wantErrs {
+				t.Fatalf("ValidateSchedule() = %v, want %d errors", errs, tt.wantErrs)
+			}
+			for _, err := range errs {
+				if !errors.Is(err, common.ErrFixtureConflict) {
+					t.Errorf("error %v is not ErrFixtureConflict", err)
+				}
+			}
+		})
+	}
+}
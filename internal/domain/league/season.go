@@ -0,0 +1,167 @@
+// domain/league/season.go
+package league
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/match"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// SeasonStatus represents the lifecycle of a season
+type SeasonStatus string
+
+const (
+	SeasonScheduled SeasonStatus = "scheduled"
+	SeasonActive    SeasonStatus = "active"
+	SeasonCompleted SeasonStatus = "completed"
+)
+
+// daysBetweenRounds spaces out generated rounds
+const daysBetweenRounds = 7
+
+// Season represents one league campaign
+type Season struct {
+	ID        string
+	LeagueID  LeagueID
+	StartDate time.Time
+	Status    SeasonStatus
+	Fixtures  []Fixture
+	Table     *LeagueTable
+
+	// MinRestDays is the minimum full days of rest between a team's matches
+	MinRestDays int
+}
+
+// NewSeason creates a season with a generated fixture list, one round a week
+func NewSeason(id string, league *League, startDate time.Time, doubleRound bool) (*Season, error) {
+	fixtures, err := GenerateFixtures(league.Teams, doubleRound)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range fixtures {
+		fixtures[i].Date = startDate.AddDate(0, 0, (fixtures[i].Round-1)*daysBetweenRounds)
+	}
+
+	return &Season{
+		ID:          id,
+		LeagueID:    league.ID,
+		StartDate:   startDate,
+		Status:      SeasonScheduled,
+		Fixtures:    fixtures,
+		Table:       NewLeagueTable(league.Teams),
+		MinRestDays: DefaultMinRestDays,
+	}, nil
+}
+
+// Start activates the season
+func (s *Season) Start() (common.SeasonStartedEvent, error) {
+	if s.Status != SeasonScheduled {
+		return common.SeasonStartedEvent{}, fmt.Errorf("season %s has already started", s.ID)
+	}
+
+	s.Status = SeasonActive
+
+	teams := []string{}
+	for _, st := range s.Table.Standings() {
+		teams = append(teams, string(st.TeamID))
+	}
+
+	return common.NewSeasonStartedEvent(s.ID, string(s.LeagueID), s.StartDate, teams), nil
+}
+
+// GetFixture retrieves a fixture by ID
+func (s *Season) GetFixture(fixtureID string) (*Fixture, error) {
+	for i := range s.Fixtures {
+		if s.Fixtures[i].ID == fixtureID {
+			return &s.Fixtures[i], nil
+		}
+	}
+	return nil, fmt.Errorf("fixture %s not found", fixtureID)
+}
+
+// RecordResult marks a fixture as played and updates the table
+func (s *Season) RecordResult(fixtureID string, result match.MatchResult) error {
+	if s.Status != SeasonActive {
+		return common.SeasonNotActive(s.ID)
+	}
+
+	fixture, err := s.GetFixture(fixtureID)
+	if err != nil {
+		return err
+	}
+	if fixture.Played {
+		return common.MatchAlreadyPlayed(fixtureID)
+	}
+	if fixture.HomeTeamID != result.HomeTeamID || fixture.AwayTeamID != result.AwayTeamID {
+		return fmt.Errorf("result teams do not match fixture %s", fixtureID)
+	}
+
+	if err := s.Table.RecordResult(result); err != nil {
+		return err
+	}
+
+	fixture.Played = true
+	fixture.HomeScore = result.HomeScore
+	fixture.AwayScore = result.AwayScore
+
+	return nil
+}
+
+// FixturesForRound returns the fixtures in a round
+func (s *Season) FixturesForRound(round int) []Fixture {
+	fixtures := []Fixture{}
+	for _, f := range s.Fixtures {
+		if f.Round == round {
+			fixtures = append(fixtures, f)
+		}
+	}
+	return fixtures
+}
+
+// FixturesForTeam returns a team's fixtures in schedule order
+func (s *Season) FixturesForTeam(teamID team.TeamID) []Fixture {
+	fixtures := []Fixture{}
+	for _, f := range s.Fixtures {
+		if f.Involves(teamID) {
+			fixtures = append(fixtures, f)
+		}
+	}
+	return fixtures
+}
+
+// IsComplete checks if every fixture has been played
+func (s *Season) IsComplete() bool {
+	for _, f := range s.Fixtures {
+		if !f.Played {
+			return false
+		}
+	}
+	return true
+}
+
+// FinalizePlayerSeasons archives the season stats of every player in the teams
+func (s *Season) FinalizePlayerSeasons(teams []*team.Team) {
+	for _, t := range teams {
+		t.UpdatePlayers(func(p *player.Player) {
+			p.FinalizeSeason(s.ID, string(t.ID))
+		})
+	}
+}
+
+// Complete closes the season once all fixtures are played
+func (s *Season) Complete() error {
+	if s.Status != SeasonActive {
+		return common.SeasonNotActive(s.ID)
+	}
+	if !s.IsComplete() {
+		return fmt.Errorf("season %s has unplayed fixtures", s.ID)
+	}
+
+	s.Status = SeasonCompleted
+	return nil
+}
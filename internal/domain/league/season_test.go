@@ -0,0 +1,40 @@
+// domain/league/season_test.go
+package league
+
+import (
+	"testing"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+func TestFinalizePlayerSeasonsOnce(t *testing.T) {
+	tm := team.NewTeam("club", "Club", team.Stadium{Name: "Ground", Capacity: 20000})
+	p := player.NewPlayer("p1", "Test", "Player", player.PositionFWD, time.Now().AddDate(-25, 0, -1))
+	p.CareerStats.CurrentSeason = player.SeasonStats{Matches: 30, Goals: 12, Minutes: 2500}
+	if err := tm.AddPlayer(*p); err != nil {
+		t.Fatal(err)
+	}
+
+	s := &Season{ID: "2026"}
+	s.FinalizePlayerSeasons([]*team.Team{tm})
+	s.FinalizePlayerSeasons([]*team.Team{tm})
+
+	got, err := tm.GetPlayer("p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(got.CareerStats.SeasonStats); n != 1 {
+		t.Fatalf("%d season records after finalizing twice, want 1", n)
+	}
+
+	archived := got.CareerStats.SeasonStats[0]
+	want := player.SeasonStats{SeasonID: "2026", TeamID: "club", Matches: 30, Goals: 12, Minutes: 2500}
+	if archived != want {
+		t.Errorf("archived season = %+v, want %+v", archived, want)
+	}
+	if got.CareerStats.CurrentSeason != (player.SeasonStats{}) {
+		t.Errorf("current season = %+v after finalizing, want it reset", got.CareerStats.CurrentSeason)
+	}
+}
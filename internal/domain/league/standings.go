@@ -0,0 +1,149 @@
+// domain/league/standings.go
+package league
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/match"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+const (
+	pointsForWin  = 3
+	pointsForDraw = 1
+)
+
+// Standing is a team's row in the league table
+type Standing struct {
+	TeamID team.TeamID
+	Stats  team.TeamSeasonStats
+}
+
+// GoalDifference returns goals scored minus goals conceded
+func (s Standing) GoalDifference() int {
+	return s.Stats.GoalsFor - s.Stats.GoalsAgainst
+}
+
+// LeagueTable tracks standings from played matches
+type LeagueTable struct {
+	rows      map[team.TeamID]*team.TeamSeasonStats
+	results   []recordedResult
+	tieBreaks TieBreakConfig
+}
+
+// NewLeagueTable creates a table with every team on zero
+func NewLeagueTable(teams []team.TeamID) *LeagueTable {
+	return NewLeagueTableWithTieBreaks(teams, DefaultTieBreakConfig())
+}
+
+// NewLeagueTableWithTieBreaks creates a table using custom tie-breakers
+func NewLeagueTableWithTieBreaks(teams []team.TeamID, tieBreaks TieBreakConfig) *LeagueTable {
+	lt := &LeagueTable{
+		rows:      make(map[team.TeamID]*team.TeamSeasonStats),
+		tieBreaks: tieBreaks,
+	}
+	for _, id := range teams {
+		lt.rows[id] = &team.TeamSeasonStats{}
+	}
+	lt.updatePositions()
+	return lt
+}
+
+// RecordResult adds a completed match to the table
+func (lt *LeagueTable) RecordResult(result match.MatchResult) error {
+	return lt.RecordScore(result.HomeTeamID, result.AwayTeamID, result.HomeScore, result.AwayScore)
+}
+
+// RecordScore adds a completed match by score
+func (lt *LeagueTable) RecordScore(homeID, awayID team.TeamID, homeGoals, awayGoals int) error {
+	home, ok := lt.rows[homeID]
+	if !ok {
+		return fmt.Errorf("team %s is not in the league", homeID)
+	}
+	away, ok := lt.rows[awayID]
+	if !ok {
+		return fmt.Errorf("team %s is not in the league", awayID)
+	}
+	if homeID == awayID {
+		return fmt.Errorf("team %s cannot play itself", homeID)
+	}
+	if homeGoals < 0 || awayGoals < 0 {
+		return fmt.Errorf("scores cannot be negative")
+	}
+
+	applyScore(home, homeGoals, awayGoals)
+	applyScore(away, awayGoals, homeGoals)
+	lt.results = append(lt.results, recordedResult{
+		home:      homeID,
+		away:      awayID,
+		homeGoals: homeGoals,
+		awayGoals: awayGoals,
+	})
+	lt.updatePositions()
+
+	return nil
+}
+
+// Stats returns a team's current season stats
+func (lt *LeagueTable) Stats(teamID team.TeamID) (team.TeamSeasonStats, bool) {
+	stats, ok := lt.rows[teamID]
+	if !ok {
+		return team.TeamSeasonStats{}, false
+	}
+	return *stats, true
+}
+
+// Standings returns the table ordered by position
+func (lt *LeagueTable) Standings() []Standing {
+	standings := make([]Standing, 0, len(lt.rows))
+	for id, stats := range lt.rows {
+		standings = append(standings, Standing{TeamID: id, Stats: *stats})
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		return standings[i].Stats.LeaguePosition < standings[j].Stats.LeaguePosition
+	})
+
+	return standings
+}
+
+// ApplyTo copies a team's table stats onto the team
+func (lt *LeagueTable) ApplyTo(t *team.Team) bool {
+	stats, ok := lt.Stats(t.ID)
+	if ok {
+		t.SeasonStats = stats
+	}
+	return ok
+}
+
+// SetTieBreakConfig changes the tie-breakers and re-ranks the table
+func (lt *LeagueTable) SetTieBreakConfig(tieBreaks TieBreakConfig) {
+	lt.tieBreaks = tieBreaks
+	lt.updatePositions()
+}
+
+// updatePositions re-ranks teams and stores each team's position
+func (lt *LeagueTable) updatePositions() {
+	for i, id := range lt.rank() {
+		lt.rows[id].LeaguePosition = i + 1
+	}
+}
+
+// applyScore updates one team's stats with a result
+func applyScore(stats *team.TeamSeasonStats, goalsFor, goalsAgainst int) {
+	stats.Played++
+	stats.GoalsFor += goalsFor
+	stats.GoalsAgainst += goalsAgainst
+
+	switch {
+	case goalsFor > goalsAgainst:
+		stats.Won++
+		stats.Points += pointsForWin
+	case goalsFor < goalsAgainst:
+		stats.Lost++
+	default:
+		stats.Drawn++
+		stats.Points += pointsForDraw
+	}
+}
@@ -0,0 +1,140 @@
+// domain/league/tiebreak.go
+package league
+
+import (
+	"sort"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// TieBreaker identifies a rule for separating teams level on points
+type TieBreaker string
+
+const (
+	TieBreakGoalDifference           TieBreaker = "goal_difference"
+	TieBreakGoalsFor                 TieBreaker = "goals_for"
+	TieBreakWins                     TieBreaker = "wins"
+	TieBreakHeadToHead               TieBreaker = "head_to_head" // Points in matches between the tied teams
+	TieBreakHeadToHeadGoalDifference TieBreaker = "head_to_head_goal_difference"
+)
+
+// TieBreakConfig lists the tie-breakers applied, in order, to teams level on points
+type TieBreakConfig struct {
+	Order []TieBreaker
+}
+
+// DefaultTieBreakConfig orders by goal difference, goals scored, then head-to-head
+func DefaultTieBreakConfig() TieBreakConfig {
+	return TieBreakConfig{
+		Order: []TieBreaker{
+			TieBreakGoalDifference,
+			TieBreakGoalsFor,
+			TieBreakHeadToHead,
+		},
+	}
+}
+
+// recordedResult keeps a played score for head-to-head calculations
+type recordedResult struct {
+	home      team.TeamID
+	away      team.TeamID
+	homeGoals int
+	awayGoals int
+}
+
+// rank orders teams by points and then the configured tie-breakers.
+// Teams still level after every rule are ordered by ID for stability.
+func (lt *LeagueTable) rank() []team.TeamID {
+	ids := make([]team.TeamID, 0, len(lt.rows))
+	for id := range lt.rows {
+		ids = append(ids, id)
+	}
+
+	points := make(map[team.TeamID]int, len(ids))
+	for _, id := range ids {
+		points[id] = lt.rows[id].Points
+	}
+
+	return lt.resolveTies(ids, points, lt.tieBreaks.Order)
+}
+
+// resolveTies sorts by a key, then breaks remaining ties with the next rules
+func (lt *LeagueTable) resolveTies(ids []team.TeamID, key map[team.TeamID]int, rules []TieBreaker) []team.TeamID {
+	sort.Slice(ids, func(i, j int) bool {
+		if key[ids[i]] != key[ids[j]] {
+			return key[ids[i]] > key[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+
+	if len(rules) == 0 {
+		return ids
+	}
+
+	ordered := make([]team.TeamID, 0, len(ids))
+	for start := 0; start < len(ids); {
+		end := start + 1
+		for end < len(ids) && key[ids[end]] == key[ids[start]] {
+			end++
+		}
+
+		group := append([]team.TeamID{}, ids[start:end]...)
+		if len(group) > 1 {
+			group = lt.resolveTies(group, lt.tieBreakKey(rules[0], group), rules[1:])
+		}
+		ordered = append(ordered, group...)
+		start = end
+	}
+
+	return ordered
+}
+
+// tieBreakKey evaluates a tie-breaker for each team in a tied group
+func (lt *LeagueTable) tieBreakKey(rule TieBreaker, group []team.TeamID) map[team.TeamID]int {
+	key := make(map[team.TeamID]int, len(group))
+
+	switch rule {
+	case TieBreakGoalDifference:
+		for _, id := range group {
+			key[id] = lt.rows[id].GoalsFor - lt.rows[id].GoalsAgainst
+		}
+	case TieBreakGoalsFor:
+		for _, id := range group {
+			key[id] = lt.rows[id].GoalsFor
+		}
+	case TieBreakWins:
+		for _, id := range group {
+			key[id] = lt.rows[id].Won
+		}
+	case TieBreakHeadToHead, TieBreakHeadToHeadGoalDifference:
+		mini := lt.headToHead(group)
+		for _, id := range group {
+			if rule == TieBreakHeadToHead {
+				key[id] = mini[id].Points
+			} else {
+				key[id] = mini[id].GoalsFor - mini[id].GoalsAgainst
+			}
+		}
+	}
+
+	return key
+}
+
+// headToHead builds a mini-table from matches played between the group
+func (lt *LeagueTable) headToHead(group []team.TeamID) map[team.TeamID]*team.TeamSeasonStats {
+	inGroup := make(map[team.TeamID]bool, len(group))
+	mini := make(map[team.TeamID]*team.TeamSeasonStats, len(group))
+	for _, id := range group {
+		inGroup[id] = true
+		mini[id] = &team.TeamSeasonStats{}
+	}
+
+	for _, r := range lt.results {
+		if inGroup[r.home] && inGroup[r.away] {
+			applyScore(mini[r.home], r.homeGoals, r.awayGoals)
+			applyScore(mini[r.away], r.awayGoals, r.homeGoals)
+		}
+	}
+
+	return mini
+}
@@ -0,0 +1,108 @@
+// domain/league/tiebreak_test.go
+package league
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// newTieBreakTable builds a table where every team has 3 points. C is top
+// and D bottom on goal difference, while A and B are identical on points,
+// goal difference and goals scored; B won the meeting between them.
+func newTieBreakTable(t *testing.T, tieBreaks TieBreakConfig) *LeagueTable {
+	t.Helper()
+	lt := NewLeagueTableWithTieBreaks([]team.TeamID{"A", "B", "C", "D"}, tieBreaks)
+	scores := []struct {
+		home, away           team.TeamID
+		homeGoals, awayGoals int
+	}{
+		{"B", "A", 1, 0},
+		{"A", "D", 1, 0},
+		{"D", "B", 1, 0},
+		{"C", "D", 3, 0},
+	}
+	for _, s := range scores {
+		if err := lt.RecordScore(s.home, s.away, s.homeGoals, s.awayGoals); err != nil {
+			t.Fatalf("RecordScore(%s v %s): %v", s.home, s.away, err)
+		}
+	}
+	return lt
+}
+
+// tableOrder returns team IDs in table position order
+func tableOrder(lt *LeagueTable) []team.TeamID {
+	ids := []team.TeamID{}
+	for i, s := range lt.Standings() {
+		if s.Stats.LeaguePosition != i+1 {
+			return nil
+		}
+		ids = append(ids, s.TeamID)
+	}
+	return ids
+}
+
+func TestLeagueTableTieBreaks(t *testing.T) {
+	tests := []struct {
+		name  string
+		order []TieBreaker
+		want  []team.TeamID
+	}{
+		{"default resolves level teams by head-to-head", DefaultTieBreakConfig().Order, []team.TeamID{"C", "B", "A", "D"}},
+		{"head-to-head goal difference", []TieBreaker{TieBreakGoalDifference, TieBreakHeadToHeadGoalDifference}, []team.TeamID{"C", "B", "A", "D"}},
+		{"without head-to-head falls back to team ID", []TieBreaker{TieBreakGoalDifference, TieBreakGoalsFor}, []team.TeamID{"C", "A", "B", "D"}},
+		{"head-to-head first covers the whole tied group", []TieBreaker{TieBreakHeadToHead, TieBreakGoalDifference}, []team.TeamID{"C", "A", "B", "D"}},
+		{"wins cannot separate anyone", []TieBreaker{TieBreakWins}, []team.TeamID{"A", "B", "C", "D"}},
+		{"no tie-breakers", nil, []team.TeamID{"A", "B", "C", "D"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := newTieBreakTable(t, TieBreakConfig{Order: tt.order})
+			if got := tableOrder(lt); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("table order = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLeagueTableSetTieBreakConfigReranks(t *testing.T) {
+	lt := newTieBreakTable(t, TieBreakConfig{Order: []TieBreaker{TieBreakGoalDifference}})
+	if stats, _ := lt.Stats("A"); stats.LeaguePosition != 2 {
+		t.Fatalf("A position = %d before head-to-head, want 2", stats.LeaguePosition)
+	}
+
+	lt.SetTieBreakConfig(DefaultTieBreakConfig())
+	if stats, _ := lt.Stats("A"); stats.LeaguePosition != 3 {
+		t.Errorf("A position = %d after head-to-head, want 3", stats.LeaguePosition)
+	}
+	if stats, _ := lt.Stats("B"); stats.LeaguePosition != 2 {
+		t.Errorf("B position = %d after head-to-head, want 2", stats.LeaguePosition)
+	}
+}
+
+func TestLeagueTableRecordScoreRejects(t *testing.T) {
+	tests := []struct {
+		name                 string
+		home, away           team.TeamID
+		homeGoals, awayGoals int
+	}{
+		{"unknown home team", "X", "A", 1, 0},
+		{"unknown away team", "A", "X", 1, 0},
+		{"team playing itself", "A", "A", 1, 0},
+		{"negative score", "A", "B", -1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lt := NewLeagueTable([]team.TeamID{"A", "B"})
+			if err := lt.RecordScore(tt.home, tt.away, tt.homeGoals, tt.awayGoals); err == nil {
+				t.Error("RecordScore accepted an invalid result")
+			}
+			if stats, _ := lt.Stats("A"); stats.Played != 0 {
+				t.Errorf("A played = %d after a rejected result, want 0", stats.Played)
+			}
+		})
+	}
+}
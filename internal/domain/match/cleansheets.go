@@ -0,0 +1,123 @@
+// domain/match/cleansheets.go
+package match
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// minCleanSheetMinutes is how long a keeper or defender must play to share
+// in a clean sheet
+const minCleanSheetMinutes = 60
+
+// CreditCleanSheet credits a clean sheet to the keepers and defenders of a
+// lineup who played at least minCleanSheetMinutes, if their side conceded
+// nothing. It returns the players credited.
+func (r MatchResult) CreditCleanSheet(lineup team.Lineup, players map[player.PlayerID]*player.Player) []player.PlayerID {
+	credited := []player.PlayerID{}
+
+	teamID, ok := r.lineupTeam(lineup)
+	if !ok || r.ConcededBy(teamID) > 0 {
+		return credited
+	}
+
+	positions := make(map[player.PlayerID]player.Position)
+	for _, a := range r.Appearances {
+		if a.TeamID == teamID {
+			positions[a.PlayerID] = a.Position
+		}
+	}
+	for i, id := range lineup.Starters {
+		if i < len(lineup.Positions) {
+			positions[id] = lineup.Positions[i]
+		}
+	}
+
+	named := append(append([]player.PlayerID{}, lineup.Starters...), lineup.Substitutes...)
+	for _, id := range named {
+		p, ok := players[id]
+		if !ok || p == nil {
+			continue
+		}
+
+		pos, ok := positions[id]
+		if !ok {
+			pos = p.Position
+		}
+		if pos != player.PositionGK && pos != player.PositionDEF {
+			continue
+		}
+
+		if r.MinutesPlayed(id, lineup) >= minCleanSheetMinutes {
+			p.RecordCleanSheet()
+			credited = append(credited, id)
+		}
+	}
+
+	return credited
+}
+
+// ConcededBy returns the goals a team conceded
+func (r MatchResult) ConcededBy(teamID team.TeamID) int {
+	if teamID == r.HomeTeamID {
+		return r.AwayScore
+	}
+	return r.HomeScore
+}
+
+// MinutesPlayed returns how long a player named in the lineup was on the
+// pitch, accounting for substitutions and red cards
+func (r MatchResult) MinutesPlayed(playerID player.PlayerID, lineup team.Lineup) int {
+	for _, a := range r.Appearances {
+		if a.PlayerID == playerID {
+			return a.Minutes
+		}
+	}
+
+	// Without recorded appearances, work it out from the lineup and events
+	length := r.Minutes
+	if length == 0 {
+		length = matchMinutes
+	}
+
+	on, off := -1, length
+	for _, id := range lineup.Starters {
+		if id == playerID {
+			on = 0
+		}
+	}
+	for _, sub := range r.Substitutions {
+		if sub.On == playerID {
+			on = sub.Minute
+		}
+		if sub.Off == playerID && sub.Minute < off {
+			off = sub.Minute
+		}
+	}
+	if on < 0 {
+		return 0
+	}
+
+	for _, c := range r.Cards {
+		if c.PlayerID == playerID && c.Type == CardRed && c.Minute < off {
+			off = c.Minute
+		}
+	}
+
+	if off < on {
+		return 0
+	}
+	return off - on
+}
+
+// lineupTeam identifies which side of the match fielded a lineup
+func (r MatchResult) lineupTeam(lineup team.Lineup) (team.TeamID, bool) {
+	for _, id := range lineup.Starters {
+		for _, a := range r.Appearances {
+			if a.PlayerID == id {
+				return a.TeamID, true
+			}
+		}
+	}
+	return "", false
+}
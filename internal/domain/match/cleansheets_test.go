@@ -0,0 +1,123 @@
+// domain/match/cleansheets_test.go
+package match
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// cleanSheetMatch builds a home lineup where the right back is replaced on
+// the hour and a midfielder covers at centre back all match
+func cleanSheetMatch(awayScore int) (MatchResult, team.Lineup, map[player.PlayerID]*player.Player) {
+	players := map[player.PlayerID]*player.Player{
+		"keeper":    newTestPlayer("keeper", player.PositionGK),
+		"leftback":  newTestPlayer("leftback", player.PositionDEF),
+		"rightback": newTestPlayer("rightback", player.PositionDEF),
+		"covering":  newTestPlayer("covering", player.PositionMID),
+		"holding":   newTestPlayer("holding", player.PositionDEF),
+		"late":      newTestPlayer("late", player.PositionDEF),
+		"early":     newTestPlayer("early", player.PositionDEF),
+	}
+	lineup := team.Lineup{
+		Starters:    []player.PlayerID{"keeper", "leftback", "rightback", "covering", "holding"},
+		Positions:   []player.Position{player.PositionGK, player.PositionDEF, player.PositionDEF, player.PositionDEF, player.PositionMID},
+		Substitutes: []player.PlayerID{"late", "early", "unused"},
+	}
+	result := MatchResult{
+		HomeTeamID: "home",
+		AwayTeamID: "away",
+		AwayScore:  awayScore,
+		Minutes:    90,
+		Appearances: []Appearance{
+			{PlayerID: "keeper", TeamID: "home", Position: player.PositionGK, Minutes: 90},
+			{PlayerID: "leftback", TeamID: "home", Position: player.PositionDEF, Minutes: 90},
+			{PlayerID: "rightback", TeamID: "home", Position: player.PositionDEF, Minutes: 59},
+			{PlayerID: "covering", TeamID: "home", Position: player.PositionDEF, Minutes: 90},
+			{PlayerID: "holding", TeamID: "home", Position: player.PositionMID, Minutes: 90},
+			{PlayerID: "late", TeamID: "home", Position: player.PositionDEF, Minutes: 31},
+			{PlayerID: "early", TeamID: "home", Position: player.PositionDEF, Minutes: 60},
+			{PlayerID: "striker", TeamID: "away", Position: player.PositionFWD, Minutes: 90},
+		},
+	}
+	return result, lineup, players
+}
+
+func TestCreditCleanSheet(t *testing.T) {
+	tests := []struct {
+		name      string
+		awayScore int
+		want      []player.PlayerID
+	}{
+		{"clean sheet", 0, []player.PlayerID{"keeper", "leftback", "covering", "early"}},
+		{"conceded", 1, []player.PlayerID{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, lineup, players := cleanSheetMatch(tt.awayScore)
+
+			got := result.CreditCleanSheet(lineup, players)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CreditCleanSheet() = %v, want %v", got, tt.want)
+			}
+
+			credited := map[player.PlayerID]bool{}
+			for _, id := range tt.want {
+				credited[id] = true
+			}
+			for id, p := range players {
+				want := 0
+				if credited[id] {
+					want = 1
+				}
+				if p.CareerStats.TotalCleanSheets != want || p.CareerStats.CurrentSeason.CleanSheets != want {
+					t.Errorf("%s clean sheets = %d career, %d season; want %d",
+						id, p.CareerStats.TotalCleanSheets, p.CareerStats.CurrentSeason.CleanSheets, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCreditCleanSheetUnknownLineup(t *testing.T) {
+	result, _, players := cleanSheetMatch(0)
+	lineup := team.Lineup{Starters: []player.PlayerID{"stranger"}}
+
+	if got := result.CreditCleanSheet(lineup, players); len(got) != 0 {
+		t.Errorf("CreditCleanSheet() = %v for a lineup not in the match, want none", got)
+	}
+}
+
+func TestMinutesPlayedFromEvents(t *testing.T) {
+	lineup := team.Lineup{
+		Starters:    []player.PlayerID{"full", "subbed", "sentoff"},
+		Substitutes: []player.PlayerID{"on", "unused"},
+	}
+	result := MatchResult{
+		Minutes:       120,
+		Substitutions: []Substitution{{Minute: 70, Off: "subbed", On: "on"}},
+		Cards:         []Card{{Minute: 40, PlayerID: "sentoff", Type: CardRed}},
+	}
+
+	tests := []struct {
+		id   player.PlayerID
+		want int
+	}{
+		{"full", 120},
+		{"subbed", 70},
+		{"sentoff", 40},
+		{"on", 50},
+		{"unused", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.id), func(t *testing.T) {
+			if got := result.MinutesPlayed(tt.id, lineup); got != tt.want {
+				t.Errorf("MinutesPlayed(%s) = %d, want %d", tt.id, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// domain/match/config.go
+package match
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// defaultExtraTime is the length of extra time in knockout matches
+const defaultExtraTime = 30
+
+// MatchConfig sets how long a match lasts and how a draw is settled
+type MatchConfig struct {
+	RegulationMinutes int
+	ExtraTimeMinutes  int  // Played only when level after regulation; 0 for none
+	ShootoutOnDraw    bool // Settle a draw after all play with penalties
+
+	// FirstLeg makes this the second leg of a tie, so extra time and
+	// penalties follow the aggregate score rather than this match's
+	FirstLeg  *MatchResult
+	AwayGoals bool // Away goals settle a level aggregate
+}
+
+// DefaultMatchConfig returns a 90-minute league match that may end level
+func DefaultMatchConfig() MatchConfig {
+	return MatchConfig{RegulationMinutes: matchMinutes}
+}
+
+// KnockoutMatchConfig returns a cup tie settled by extra time and then
+// penalties
+func KnockoutMatchConfig() MatchConfig {
+	return MatchConfig{
+		RegulationMinutes: matchMinutes,
+		ExtraTimeMinutes:  defaultExtraTime,
+		ShootoutOnDraw:    true,
+	}
+}
+
+// regulation returns the regulation length, defaulting when unset
+func (c MatchConfig) regulation() int {
+	if c.RegulationMinutes <= 0 {
+		return matchMinutes
+	}
+	return c.RegulationMinutes
+}
+
+// SimulateWithConfig plays a match under the given config, deterministic
+// for a given seed
+func SimulateWithConfig(home, away *team.Team, homeLineup, awayLineup team.Lineup, seed int64, config MatchConfig) MatchResult {
+	state := newAutoManagedState(home, away, homeLineup, awayLineup, seed)
+	state.Config = config
+	state.PlayToEnd()
+	return state.Result()
+}
+
+// level reports whether the match, or the tie for a second leg, is level
+// on the current score
+func (s *MatchState) level() bool {
+	if s.Config.FirstLeg == nil {
+		return s.home.goals == s.away.goals
+	}
+
+	// The second leg's home side was away in the first leg
+	leg := s.Config.FirstLeg
+	homeAggregate := leg.AwayScore + s.home.goals
+	awayAggregate := leg.HomeScore + s.away.goals
+	if homeAggregate != awayAggregate {
+		return false
+	}
+	return !s.Config.AwayGoals || leg.AwayScore == s.away.goals
+}
+
+// extraTimePlayed returns how many of a player's minutes fell in extra time
+func (s *MatchState) extraTimePlayed(minute, entered int) int {
+	start := s.Config.regulation()
+	if entered > start {
+		start = entered
+	}
+	if minute <= start {
+		return 0
+	}
+	return minute - start
+}
+
+// decideShootout settles a level match with penalties, taken by the
+// players on the pitch at the final whistle
+func (s *MatchState) decideShootout() {
+	onPitch := func(sd *side) (team.Lineup, map[player.PlayerID]*player.Player) {
+		lineup := team.Lineup{Formation: sd.lineup.Formation}
+		players := make(map[player.PlayerID]*player.Player, len(sd.players))
+		for i, p := range sd.players {
+			lineup.Starters = append(lineup.Starters, p.ID)
+			lineup.Positions = append(lineup.Positions, sd.positions[i])
+			players[p.ID] = p
+		}
+		return lineup, players
+	}
+
+	homeLineup, homePlayers := onPitch(s.home)
+	awayLineup, awayPlayers := onPitch(s.away)
+	shootout := ResolveShootoutWithSource(homeLineup, awayLineup, homePlayers, awayPlayers, s.rand)
+	s.result.Shootout = &shootout
+
+	s.addEvent(MatchEvent{Minute: s.minute, Type: EventShootout})
+}
@@ -0,0 +1,102 @@
+// domain/match/config_test.go
+package match
+
+import (
+	"testing"
+)
+
+const configSeeds = 200
+
+func TestExtraTimeDrainsMoreFitness(t *testing.T) {
+	home, homeLineup := newTestSide(t, "home", 0)
+	away, awayLineup := newTestSide(t, "away", 0)
+	extraTime := MatchConfig{RegulationMinutes: matchMinutes, ExtraTimeMinutes: defaultExtraTime}
+
+	compared := 0
+	for seed := int64(0); seed < configSeeds; seed++ {
+		regular := SimulateWithConfig(home, away, homeLineup, awayLineup, seed, DefaultMatchConfig())
+		extended := SimulateWithConfig(home, away, homeLineup, awayLineup, seed, extraTime)
+
+		if regular.Minutes != matchMinutes {
+			t.Fatalf("seed %d: default match lasted %d minutes, want %d", seed, regular.Minutes, matchMinutes)
+		}
+		if regular.HomeScore != regular.AwayScore {
+			if extended.ExtraTime || extended.Minutes != matchMinutes {
+				t.Errorf("seed %d: decided match went to extra time", seed)
+			}
+			continue
+		}
+
+		if !extended.ExtraTime || extended.Minutes != matchMinutes+defaultExtraTime {
+			t.Fatalf("seed %d: level match lasted %d minutes, want %d with extra time",
+				seed, extended.Minutes, matchMinutes+defaultExtraTime)
+		}
+		var regularFitness, extendedFitness float64
+		for id, f := range extended.Fitness {
+			if before, ok := regular.Fitness[id]; ok {
+				regularFitness += before
+				extendedFitness += f
+			}
+		}
+		if extendedFitness >= regularFitness {
+			t.Errorf("seed %d: fitness after extra time = %.1f, after 90 minutes = %.1f; want extra time to drain more",
+				seed, extendedFitness, regularFitness)
+		}
+		compared++
+	}
+	if compared == 0 {
+		t.Fatal("no level matches to compare")
+	}
+}
+
+func TestShootoutOnlyWhenConfiguredAndLevel(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       MatchConfig
+		wantShootout bool // Whether level matches are settled on penalties
+		wantMinutes  int  // Length of a match level at full time
+	}{
+		{"league match", DefaultMatchConfig(), false, matchMinutes},
+		{"unset config defaults to 90 minutes", MatchConfig{}, false, matchMinutes},
+		{"extra time only", MatchConfig{ExtraTimeMinutes: defaultExtraTime}, false, matchMinutes + defaultExtraTime},
+		{"straight to penalties", MatchConfig{RegulationMinutes: matchMinutes, ShootoutOnDraw: true}, true, matchMinutes},
+		{"knockout", KnockoutMatchConfig(), true, matchMinutes + defaultExtraTime},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home, homeLineup := newTestSide(t, "home", 0)
+			away, awayLineup := newTestSide(t, "away", 0)
+
+			level := 0
+			for seed := int64(0); seed < configSeeds; seed++ {
+				result := SimulateWithConfig(home, away, homeLineup, awayLineup, seed, tt.config)
+				_, won := result.Winner()
+
+				if result.HomeScore != result.AwayScore {
+					if result.Shootout != nil {
+						t.Fatalf("seed %d: %d-%d result went to penalties", seed, result.HomeScore, result.AwayScore)
+					}
+					if !won {
+						t.Fatalf("seed %d: %d-%d result has no winner", seed, result.HomeScore, result.AwayScore)
+					}
+					continue
+				}
+
+				level++
+				if result.Minutes != tt.wantMinutes {
+					t.Errorf("seed %d: level match lasted %d minutes, want %d", seed, result.Minutes, tt.wantMinutes)
+				}
+				if (result.Shootout != nil) != tt.wantShootout {
+					t.Fatalf("seed %d: shootout = %v, want %v", seed, result.Shootout != nil, tt.wantShootout)
+				}
+				if won != tt.wantShootout {
+					t.Errorf("seed %d: level match has winner = %v, want %v", seed, won, tt.wantShootout)
+				}
+			}
+			if level == 0 {
+				t.Fatal("no level matches to check")
+			}
+		})
+	}
+}
@@ -0,0 +1,552 @@
+// domain/match/engine.go
+package match
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+const (
+	matchMinutes      = 90
+	homeAdvantage     = 1.05 // Boost to home possession and attack
+	shotChance        = 0.25 // Chance the team in possession creates a shot each minute
+	baseConversion    = 0.11 // Goal probability for an evenly matched shot
+	shotOnTargetRatio = 0.35 // Share of non-scoring shots that test the keeper
+	bookingChance     = 0.035
+	assistChance      = 0.75
+	matchIntensity    = 1.0
+	derbyIntensity    = 1.15 // Extra physical demand of a derby
+	derbyCardFactor   = 1.4  // Derbies produce more bookings
+	artificialFatigue = 1.05 // Extra fatigue from playing on artificial turf
+	tiredThreshold    = 60.0 // Fitness below which a player is flagged as tiring
+	straightRedRatio  = 0.04 // Share of bookings that are straight reds
+	baseInjuryRisk    = 0.0001
+	injuredModifier   = 0.5 // Effectiveness of a player carrying an injury
+	maxSubstitutions  = 5
+	autoSubMinute     = 60 // Earliest minute the auto-manager rotates tired players
+)
+
+// side holds one team's state during a match
+type side struct {
+	team      *team.Team
+	lineup    team.Lineup
+	players   []*player.Player
+	positions []player.Position
+	entered   []int  // Minute each player came on
+	tired     []bool // Whether a fitness drop has been reported
+	injured   []bool // Whether the player is carrying an injury
+	fitness   []float64
+	strength  lineStrength
+	formation float64 // Formation matchup multiplier
+	chemistry float64 // Lineup chemistry multiplier
+	momentum  float64 // Strength swing from recent results
+	pitch     float64 // Strength swing from the playing surface
+	fatigue   float64 // Fatigue multiplier from tactics
+	cards     float64 // Booking multiplier from tactics
+	isHome    bool
+
+	setPieces team.SetPieceTakers // Designated takers, who may not be on the pitch
+
+	bench    []*player.Player
+	subsUsed int
+	appeared []appearance // Everyone who took the field, in order
+
+	goals      int
+	possession int // Minutes in control of the ball
+	stats      TeamMatchStats
+}
+
+// appearance records a player's involvement in the match
+type appearance struct {
+	player   *player.Player
+	position player.Position
+	on       int // Minute the player came on
+	off      int // Minute the player went off, or -1 if still on
+}
+
+// MatchState tracks a match in progress
+type MatchState struct {
+	// MaxSubstitutions limits substitutions per side
+	MaxSubstitutions int
+	// AutoSubstitutions lets the engine replace tired players from the bench
+	AutoSubstitutions bool
+	// BigMatch marks a high-stakes game where temperament affects ratings
+	BigMatch bool
+	// Config sets the match length and how a draw is settled
+	Config MatchConfig
+
+	derby     bool
+	intensity float64
+	rand      *rand.Rand
+	fitness   *player.FitnessManager
+	home      *side
+	away      *side
+	minute    int
+	finished  bool
+	result    MatchResult
+	timeline  []MatchEvent
+}
+
+// Simulate plays a match between two lineups, deterministic for a given seed
+func Simulate(home, away *team.Team, homeLineup, awayLineup team.Lineup, seed int64) MatchResult {
+	state := newAutoManagedState(home, away, homeLineup, awayLineup, seed)
+	state.PlayToEnd()
+	return state.Result()
+}
+
+// SimulateWithTimeline plays a match and returns its ordered in-game events.
+// The same seed produces the same scoreline as Simulate.
+func SimulateWithTimeline(home, away *team.Team, homeLineup, awayLineup team.Lineup, seed int64) []MatchEvent {
+	state := newAutoManagedState(home, away, homeLineup, awayLineup, seed)
+	state.PlayToEnd()
+	return state.Timeline()
+}
+
+// newAutoManagedState prepares a match where the engine manages substitutions
+func newAutoManagedState(home, away *team.Team, homeLineup, awayLineup team.Lineup, seed int64) *MatchState {
+	state := NewMatchState(home, away, homeLineup, awayLineup, seed)
+	state.AutoSubstitutions = true
+	return state
+}
+
+// NewMatchState prepares a match for minute-by-minute play
+func NewMatchState(home, away *team.Team, homeLineup, awayLineup team.Lineup, seed int64) *MatchState {
+	state := &MatchState{
+		MaxSubstitutions: maxSubstitutions,
+		Config:           DefaultMatchConfig(),
+		rand:             rand.New(rand.NewSource(seed)),
+		fitness:          player.NewFitnessManager(),
+		timeline:         []MatchEvent{},
+	}
+	state.home, state.away = newMatchSides(home, away, homeLineup, awayLineup)
+
+	state.derby = home.IsRivalWith(string(away.ID)) || away.IsRivalWith(string(home.ID))
+	state.intensity = matchIntensity
+	if state.derby {
+		state.intensity *= derbyIntensity
+	}
+
+	if team.NormalizePitchType(home.Stadium.PitchType) == team.PitchArtificial {
+		state.intensity *= artificialFatigue
+	}
+
+	state.result = MatchResult{
+		HomeTeamID:    home.ID,
+		AwayTeamID:    away.ID,
+		Derby:         state.derby,
+		Goals:         []Goal{},
+		Cards:         []Card{},
+		Injuries:      []Injury{},
+		Ratings:       make(map[player.PlayerID]float64),
+		Fitness:       make(map[player.PlayerID]float64),
+		Substitutions: []Substitution{},
+	}
+
+	state.addEvent(MatchEvent{Minute: 0, Type: EventKickoff, TeamID: home.ID})
+	return state
+}
+
+// newMatchSides sets up both sides at the home team's ground, adjusted for
+// the playing surface and the formation matchup
+func newMatchSides(home, away *team.Team, homeLineup, awayLineup team.Lineup) (*side, *side) {
+	homeSide := newSide(home, homeLineup, true)
+	awaySide := newSide(away, awayLineup, false)
+
+	venue := team.NormalizePitchType(home.Stadium.PitchType)
+	homeSide.pitch = home.PitchAdvantage(venue)
+	awaySide.pitch = away.PitchAdvantage(venue)
+	homeSide.updateStrength()
+	awaySide.updateStrength()
+
+	homeSide.formation = homeLineup.Formation.GetFormationStrengthAtVenue(awayLineup.Formation, true)
+	awaySide.formation = awayLineup.Formation.GetFormationStrengthAtVenue(homeLineup.Formation, false)
+
+	return homeSide, awaySide
+}
+
+// newSide resolves a team's starters and strength
+func newSide(t *team.Team, lineup team.Lineup, isHome bool) *side {
+	players, positions := resolveLineup(t, lineup)
+
+	fitness := make([]float64, len(players))
+	appeared := make([]appearance, len(players))
+	for i, p := range players {
+		fitness[i] = p.Fitness
+		appeared[i] = appearance{player: p, position: positions[i], off: -1}
+	}
+
+	bench := []*player.Player{}
+	for _, id := range lineup.Substitutes {
+		if p, err := t.GetPlayer(id); err == nil {
+			bench = append(bench, p)
+		}
+	}
+
+	sd := &side{
+		team:      t,
+		lineup:    lineup,
+		players:   players,
+		positions: positions,
+		entered:   make([]int, len(players)),
+		tired:     make([]bool, len(players)),
+		injured:   make([]bool, len(players)),
+		fitness:   fitness,
+		formation: 1.0,
+		chemistry: team.NewSquadManager(t).CalculateChemistry(lineup),
+		momentum:  t.GetMomentum(),
+		fatigue:   t.Tactics.FatigueMultiplier(),
+		cards:     t.Tactics.CardMultiplier(),
+		isHome:    isHome,
+		setPieces: team.NewSquadManager(t).GetSetPieceTakers(),
+		bench:     bench,
+		appeared:  appeared,
+	}
+	sd.updateStrength()
+	return sd
+}
+
+// updateStrength recalculates line strength, accounting for fatigue,
+// injuries and players sent off
+func (sd *side) updateStrength() {
+	modifiers := make([]float64, len(sd.players))
+	for i, p := range sd.players {
+		// Rate the player on their in-match, not pre-match, fitness
+		current := *p
+		current.Fitness = sd.fitness[i]
+		modifiers[i] = current.GetMatchPerformanceModifier()
+		if sd.injured[i] {
+			modifiers[i] *= injuredModifier
+		}
+	}
+	sd.strength = calculateLineStrength(sd.players, sd.positions, modifiers)
+
+	// Every missing player leaves gaps across the pitch, while a settled
+	// lineup in good form on a familiar surface plays above the sum of
+	// its parts
+	multiplier := float64(len(sd.players)) / 11 * sd.chemistry * (1 + sd.momentum) * (1 + sd.pitch)
+	sd.strength.Goalkeeping *= multiplier
+	sd.strength.Defense *= multiplier
+	sd.strength.Midfield *= multiplier
+	sd.strength.Attack *= multiplier
+}
+
+// removeSlot takes a player off the pitch without a replacement
+func (sd *side) removeSlot(slot, minute int) {
+	sd.markOff(sd.players[slot].ID, minute)
+	sd.players = append(sd.players[:slot], sd.players[slot+1:]...)
+	sd.positions = append(sd.positions[:slot], sd.positions[slot+1:]...)
+	sd.entered = append(sd.entered[:slot], sd.entered[slot+1:]...)
+	sd.tired = append(sd.tired[:slot], sd.tired[slot+1:]...)
+	sd.injured = append(sd.injured[:slot], sd.injured[slot+1:]...)
+	sd.fitness = append(sd.fitness[:slot], sd.fitness[slot+1:]...)
+	sd.updateStrength()
+}
+
+// markOff records the minute a player left the pitch
+func (sd *side) markOff(playerID player.PlayerID, minute int) {
+	for i := range sd.appeared {
+		if sd.appeared[i].player.ID == playerID && sd.appeared[i].off < 0 {
+			sd.appeared[i].off = minute
+		}
+	}
+}
+
+// Minute returns the last minute played
+func (s *MatchState) Minute() int {
+	return s.minute
+}
+
+// IsFinished checks if the final whistle has blown
+func (s *MatchState) IsFinished() bool {
+	return s.finished
+}
+
+// PlayMinute plays the next minute, finishing the match at full time
+func (s *MatchState) PlayMinute() {
+	if s.finished {
+		return
+	}
+
+	s.minute++
+	minute := s.minute
+
+	s.applyFatigue(minute, s.home)
+	s.applyFatigue(minute, s.away)
+	s.checkInjuries(minute, s.home)
+	s.checkInjuries(minute, s.away)
+
+	if s.AutoSubstitutions && minute >= autoSubMinute {
+		s.autoSubstitute(s.home)
+		s.autoSubstitute(s.away)
+	}
+
+	attacking, defending := s.away, s.home
+	if s.rand.Float64() < possessionShare(s.home, s.away) {
+		attacking, defending = s.home, s.away
+	}
+	attacking.possession++
+
+	s.playMinute(minute, attacking, defending)
+
+	regulation := s.Config.regulation()
+	if minute == regulation/2 {
+		s.addEvent(MatchEvent{Minute: minute, Type: EventHalfTime})
+	}
+
+	switch {
+	case minute == regulation && s.level() && s.Config.ExtraTimeMinutes > 0:
+		s.result.ExtraTime = true
+		s.addEvent(MatchEvent{Minute: minute, Type: EventExtraTime})
+	case minute == regulation && !s.result.ExtraTime,
+		minute == regulation+s.Config.ExtraTimeMinutes:
+		s.finish()
+	}
+}
+
+// PlayToEnd plays all remaining minutes
+func (s *MatchState) PlayToEnd() {
+	for !s.finished {
+		s.PlayMinute()
+	}
+}
+
+// Result returns the match result, complete once the match has finished
+func (s *MatchState) Result() MatchResult {
+	return s.result
+}
+
+// Timeline returns the in-game events so far
+func (s *MatchState) Timeline() []MatchEvent {
+	return s.timeline
+}
+
+// finish blows the final whistle and compiles the result
+func (s *MatchState) finish() {
+	s.finished = true
+	s.addEvent(MatchEvent{Minute: s.minute, Type: EventFullTime})
+
+	s.home.stats.Possession = math.Round(float64(s.home.possession)/float64(s.minute)*1000) / 10
+	s.away.stats.Possession = 100 - s.home.stats.Possession
+
+	for _, sd := range []*side{s.home, s.away} {
+		for i, p := range sd.players {
+			s.result.Fitness[p.ID] = sd.fitness[i]
+		}
+		for _, a := range sd.appeared {
+			off := a.off
+			if off < 0 {
+				off = s.minute
+			}
+			s.result.Appearances = append(s.result.Appearances, Appearance{
+				PlayerID: a.player.ID,
+				TeamID:   sd.team.ID,
+				Position: a.position,
+				Minutes:  off - a.on,
+			})
+		}
+	}
+
+	s.result.Minutes = s.minute
+	s.result.HomeScore = s.home.goals
+	s.result.AwayScore = s.away.goals
+	if s.Config.ShootoutOnDraw && s.level() {
+		s.decideShootout()
+	}
+	s.result.HomeStats = s.home.stats
+	s.result.AwayStats = s.away.stats
+	s.rateSide(s.home, s.away)
+	s.rateSide(s.away, s.home)
+}
+
+// applyFatigue projects each player's fitness and flags those tiring
+func (s *MatchState) applyFatigue(minute int, sd *side) {
+	for i, p := range sd.players {
+		extraTime := s.extraTimePlayed(minute, sd.entered[i])
+		sd.fitness[i] = s.fitness.FitnessAtMinuteWithExtraTime(p, minute-sd.entered[i], extraTime, s.intensity*sd.fatigue)
+
+		if !sd.tired[i] && sd.fitness[i] < tiredThreshold {
+			sd.tired[i] = true
+			s.addEvent(MatchEvent{
+				Minute:    minute,
+				Type:      EventFitnessDrop,
+				TeamID:    sd.team.ID,
+				PlayerIDs: []player.PlayerID{p.ID},
+			})
+		}
+	}
+	sd.updateStrength()
+}
+
+// addEvent appends an event to the match timeline
+func (s *MatchState) addEvent(event MatchEvent) {
+	s.timeline = append(s.timeline, event)
+}
+
+// playMinute resolves chances and bookings for one minute
+func (s *MatchState) playMinute(minute int, attacking, defending *side) {
+	if s.rand.Float64() < shotChance {
+		s.resolveShot(minute, attacking, defending)
+	}
+
+	booking := bookingChance * defending.cards
+	if s.derby {
+		booking *= derbyCardFactor
+	}
+	if s.rand.Float64() < booking {
+		s.resolveBooking(minute, defending)
+	}
+}
+
+// resolveShot decides whether a chance becomes a goal
+func (s *MatchState) resolveShot(minute int, attacking, defending *side) {
+	shooter, goalType, chance := s.createChance(attacking, defending)
+	if shooter == nil {
+		return
+	}
+
+	attacking.stats.Shots++
+	attacking.stats.ExpectedGoals += chance
+
+	if s.rand.Float64() < chance {
+		attacking.stats.ShotsOnTarget++
+		attacking.goals++
+		if goalType == player.GoalOpenPlay && s.rand.Float64() < headerShare(shooter) {
+			goalType = player.GoalHeader
+		}
+		s.recordGoal(minute, attacking, shooter, goalType)
+		return
+	}
+
+	if s.rand.Float64() < shotOnTargetRatio {
+		attacking.stats.ShotsOnTarget++
+	}
+
+	s.addEvent(MatchEvent{
+		Minute:    minute,
+		Type:      EventChance,
+		TeamID:    attacking.team.ID,
+		PlayerIDs: []player.PlayerID{shooter.ID},
+	})
+}
+
+// recordGoal credits a goal and picks a possible assister
+func (s *MatchState) recordGoal(minute int, attacking *side, scorer *player.Player, goalType player.GoalType) {
+	goal := Goal{
+		Minute:   minute,
+		TeamID:   attacking.team.ID,
+		PlayerID: scorer.ID,
+		Type:     goalType,
+	}
+
+	// Penalties and direct free kicks are unassisted
+	assisted := goalType == player.GoalOpenPlay || goalType == player.GoalHeader
+	if assisted && s.rand.Float64() < assistChance {
+		assister := s.pickWeighted(attacking, func(p *player.Player, pos player.Position) float64 {
+			return creatingWeight(pos) * float64(p.Attributes.Passing+p.Attributes.Perception)
+		}, scorer.ID)
+		if assister != nil {
+			goal.AssistBy = assister.ID
+		}
+	}
+
+	s.result.Goals = append(s.result.Goals, goal)
+
+	involved := []player.PlayerID{scorer.ID}
+	if goal.AssistBy != "" {
+		involved = append(involved, goal.AssistBy)
+	}
+	s.addEvent(MatchEvent{
+		Minute:    minute,
+		Type:      EventGoal,
+		TeamID:    attacking.team.ID,
+		PlayerIDs: involved,
+	})
+}
+
+// pickWeighted selects a player from a side using the given weighting
+func (s *MatchState) pickWeighted(sd *side, weight func(*player.Player, player.Position) float64, exclude player.PlayerID) *player.Player {
+	weights := make([]float64, len(sd.players))
+	total := 0.0
+	for i, p := range sd.players {
+		if p.ID == exclude {
+			continue
+		}
+		weights[i] = math.Max(0, weight(p, sd.positions[i]))
+		total += weights[i]
+	}
+	if total == 0 {
+		return nil
+	}
+
+	roll := s.rand.Float64() * total
+	for i, w := range weights {
+		roll -= w
+		if roll < 0 && w > 0 {
+			return sd.players[i]
+		}
+	}
+	return nil
+}
+
+// rateSide assigns 1-10 match ratings to a side's players
+func (s *MatchState) rateSide(sd, opponent *side) {
+	resultBonus := 0.0
+	switch {
+	case sd.goals > opponent.goals:
+		resultBonus = 0.3
+	case sd.goals < opponent.goals:
+		resultBonus = -0.3
+	}
+
+	for _, a := range sd.appeared {
+		p, pos := a.player, a.position
+
+		rating := 6.0 + resultBonus
+		rating += (ratingAtPosition(p, pos) - 70) / 20
+		rating += float64(s.result.GoalsBy(p.ID)) * 1.0
+		rating += float64(s.result.AssistsBy(p.ID)) * 0.5
+		rating -= float64(s.result.CardsFor(p.ID, CardYellow)) * 0.3
+		rating -= float64(s.result.CardsFor(p.ID, CardRed)) * 1.5
+
+		if pos == player.PositionGK || pos == player.PositionDEF {
+			if opponent.goals == 0 {
+				rating += 0.5
+			}
+			rating -= float64(opponent.goals) * 0.2
+		}
+
+		rating = p.SampleMatchRatingWithSource(rating, s.BigMatch, s.rand)
+		s.result.Ratings[p.ID] = math.Round(rating*10) / 10
+	}
+}
+
+// scoringWeight reflects how often each position finds the net
+func scoringWeight(pos player.Position) float64 {
+	switch pos {
+	case player.PositionFWD:
+		return 5
+	case player.PositionMID:
+		return 2.5
+	case player.PositionDEF:
+		return 0.7
+	default:
+		return 0
+	}
+}
+
+// creatingWeight reflects how often each position provides assists
+func creatingWeight(pos player.Position) float64 {
+	switch pos {
+	case player.PositionMID:
+		return 4
+	case player.PositionFWD:
+		return 3
+	case player.PositionDEF:
+		return 1.5
+	default:
+		return 0.2
+	}
+}
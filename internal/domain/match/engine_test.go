@@ -0,0 +1,102 @@
+// domain/match/engine_test.go
+package match
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// testSeeds is how many seeded matches each engine property is checked over
+const testSeeds = 50
+
+// onPitchAtGoals replays a timeline and returns, for each goal in order,
+// whether the scorer was on the pitch for their team at the time
+func onPitchAtGoals(timeline []MatchEvent, homeLineup, awayLineup team.Lineup) []bool {
+	onPitch := make(map[player.PlayerID]bool)
+	for _, id := range append(append([]player.PlayerID{}, homeLineup.Starters...), awayLineup.Starters...) {
+		onPitch[id] = true
+	}
+
+	scored := []bool{}
+	for _, e := range timeline {
+		switch e.Type {
+		case EventSubstitution:
+			onPitch[e.PlayerIDs[0]] = false
+			onPitch[e.PlayerIDs[1]] = true
+		case EventCard:
+			if e.Card == CardRed {
+				onPitch[e.PlayerIDs[0]] = false
+			}
+		case EventGoal:
+			scored = append(scored, onPitch[e.PlayerIDs[0]])
+		}
+	}
+	return scored
+}
+
+func TestSimulateDeterministic(t *testing.T) {
+	home, homeLineup := newTestSide(t, "home", 5)
+	away, awayLineup := newTestSide(t, "away", 5)
+
+	for seed := int64(1); seed <= testSeeds; seed++ {
+		first := Simulate(home, away, homeLineup, awayLineup, seed)
+		second := Simulate(home, away, homeLineup, awayLineup, seed)
+		if !reflect.DeepEqual(first, second) {
+			t.Fatalf("seed %d: results differ between runs:\n%+v\n%+v", seed, first, second)
+		}
+	}
+}
+
+func TestSimulateGoalsMatchScore(t *testing.T) {
+	home, homeLineup := newTestSide(t, "home", 5)
+	away, awayLineup := newTestSide(t, "away", 5)
+
+	for seed := int64(1); seed <= testSeeds; seed++ {
+		result := Simulate(home, away, homeLineup, awayLineup, seed)
+		if got, want := len(result.Goals), result.HomeScore+result.AwayScore; got != want {
+			t.Errorf("seed %d: %d goals recorded for a %d-%d scoreline", seed, got, result.HomeScore, result.AwayScore)
+		}
+
+		appeared := make(map[player.PlayerID]team.TeamID)
+		for _, a := range result.Appearances {
+			appeared[a.PlayerID] = a.TeamID
+		}
+		onPitch := onPitchAtGoals(SimulateWithTimeline(home, away, homeLineup, awayLineup, seed), homeLineup, awayLineup)
+		for i, g := range result.Goals {
+			if appeared[g.PlayerID] != g.TeamID {
+				t.Errorf("seed %d: %s scored for %s without appearing for them", seed, g.PlayerID, g.TeamID)
+			}
+			if i < len(onPitch) && !onPitch[i] {
+				t.Errorf("seed %d: %s scored in minute %d while off the pitch", seed, g.PlayerID, g.Minute)
+			}
+		}
+	}
+}
+
+func TestSimulateWithTimelineMatchesSimulate(t *testing.T) {
+	home, homeLineup := newTestSide(t, "home", 5)
+	away, awayLineup := newTestSide(t, "away", 5)
+
+	for seed := int64(1); seed <= testSeeds; seed++ {
+		result := Simulate(home, away, homeLineup, awayLineup, seed)
+		timeline := SimulateWithTimeline(home, away, homeLineup, awayLineup, seed)
+
+		goals := make(map[team.TeamID]int)
+		for i, e := range timeline {
+			if e.Type == EventGoal {
+				goals[e.TeamID]++
+			}
+			if i > 0 && e.Minute < timeline[i-1].Minute {
+				t.Errorf("seed %d: %s event in minute %d follows minute %d", seed, e.Type, e.Minute, timeline[i-1].Minute)
+			}
+		}
+
+		if goals["home"] != result.HomeScore || goals["away"] != result.AwayScore {
+			t.Errorf("seed %d: timeline has %d-%d in goals, Simulate gives %d-%d",
+				seed, goals["home"], goals["away"], result.HomeScore, result.AwayScore)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+// domain/match/events.go
+package match
+
+import (
+	"errors"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// MatchEventType represents the kind of in-game event
+type MatchEventType string
+
+const (
+	EventKickoff      MatchEventType = "kickoff"
+	EventChance       MatchEventType = "chance"
+	EventGoal         MatchEventType = "goal"
+	EventCard         MatchEventType = "card"
+	EventInjury       MatchEventType = "injury"
+	EventSubstitution MatchEventType = "substitution"
+	EventFitnessDrop  MatchEventType = "fitness_drop"
+	EventHalfTime     MatchEventType = "half_time"
+	EventExtraTime    MatchEventType = "extra_time"
+	EventFullTime     MatchEventType = "full_time"
+	EventShootout     MatchEventType = "shootout"
+)
+
+// MatchEvent is a single entry in a match timeline
+type MatchEvent struct {
+	Minute    int
+	Type      MatchEventType
+	TeamID    team.TeamID       // Empty for events involving both teams
+	PlayerIDs []player.PlayerID // Scorer first, then assister, etc.
+	Card      CardType          // Set for card events
+}
+
+// GoalEvents converts the match goals into domain events
+func (r MatchResult) GoalEvents(matchID string) []common.GoalScoredEvent {
+	events := make([]common.GoalScoredEvent, 0, len(r.Goals))
+	for _, g := range r.Goals {
+		event := common.NewGoalScoredEvent(matchID, string(g.PlayerID), string(g.TeamID), g.Minute)
+		event.AssistBy = string(g.AssistBy)
+		event.GoalType = string(g.Type)
+		events = append(events, event)
+	}
+	return events
+}
+
+// CardEvents converts the match bookings into domain events
+func (r MatchResult) CardEvents(matchID string) []common.CardIssuedEvent {
+	events := make([]common.CardIssuedEvent, 0, len(r.Cards))
+	for _, c := range r.Cards {
+		events = append(events, common.NewCardIssuedEvent(matchID, string(c.PlayerID), string(c.TeamID), string(c.Type), c.Minute))
+	}
+	return events
+}
+
+// InjuryEvents converts the match injuries into domain events
+func (r MatchResult) InjuryEvents() []common.PlayerInjuredEvent {
+	events := make([]common.PlayerInjuredEvent, 0, len(r.Injuries))
+	for _, i := range r.Injuries {
+		events = append(events, common.NewPlayerInjuredEvent(string(i.PlayerID), string(i.Type), i.ExpectedDays))
+	}
+	return events
+}
+
+// ApplyInjuries rules out a team's players injured in the match. Injuries
+// to players not in the team are skipped.
+func (r MatchResult) ApplyInjuries(t *team.Team) error {
+	var errs []error
+	for _, injury := range r.Injuries {
+		_ = t.UpdatePlayer(injury.PlayerID, func(p *player.Player) {
+			err := p.ApplyInjury(player.Injury{
+				Type:          injury.Type,
+				Days:          injury.ExpectedDays,
+				AttributeLoss: injury.AttributeLoss,
+				OccurredAt:    time.Now(),
+			})
+			if err != nil {
+				errs = append(errs, err)
+			}
+		})
+	}
+	return errors.Join(errs...)
+}
+
+// RecordPartnerships notes that a team's players who took the field in the
+// match played together, building their chemistry
+func (r MatchResult) RecordPartnerships(t *team.Team) {
+	featured := []player.PlayerID{}
+	for id := range r.Ratings {
+		if _, err := t.GetPlayer(id); err == nil {
+			featured = append(featured, id)
+		}
+	}
+	t.RecordMatchTogether(featured)
+}
+
+// ApplyPlayerStats records the match in the stats of a team's players who
+// took part, including the minutes each spent on the pitch, and returns the
+// milestones and suspensions that followed
+func (r MatchResult) ApplyPlayerStats(t *team.Team) (map[player.PlayerID]player.MatchUpdate, error) {
+	updates := make(map[player.PlayerID]player.MatchUpdate)
+	var errs []error
+
+	for _, a := range r.Appearances {
+		if a.TeamID != t.ID {
+			continue
+		}
+		_ = t.UpdatePlayer(a.PlayerID, func(p *player.Player) {
+			update, err := p.UpdateMatchStatsWithGoals(
+				r.GoalTypesBy(p.ID),
+				r.AssistsBy(p.ID),
+				r.MatchCardsFor(p.ID),
+				r.Ratings[p.ID],
+			)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			updates[p.ID] = update
+			p.RecordMinutes(a.Minutes)
+		})
+	}
+
+	return updates, errors.Join(errs...)
+}
+
+// CompletedEvent converts the result into a match completed event
+func (r MatchResult) CompletedEvent(matchID string) common.MatchCompletedEvent {
+	stats := map[string]interface{}{
+		"home_team_id":         string(r.HomeTeamID),
+		"away_team_id":         string(r.AwayTeamID),
+		"home_shots":           r.HomeStats.Shots,
+		"away_shots":           r.AwayStats.Shots,
+		"home_shots_on_target": r.HomeStats.ShotsOnTarget,
+		"away_shots_on_target": r.AwayStats.ShotsOnTarget,
+		"home_possession":      r.HomeStats.Possession,
+		"away_possession":      r.AwayStats.Possession,
+		"home_xg":              r.HomeStats.ExpectedGoals,
+		"away_xg":              r.AwayStats.ExpectedGoals,
+	}
+	return common.NewMatchCompletedEvent(matchID, r.HomeScore, r.AwayScore, stats)
+}
+
+// TeamResult converts the result into a team's recent-form entry
+func (r MatchResult) TeamResult(matchID string, teamID team.TeamID) team.MatchResult {
+	isHome := teamID == r.HomeTeamID
+
+	result := team.MatchResult{
+		MatchID:      matchID,
+		IsHome:       isHome,
+		GoalsFor:     r.HomeScore,
+		GoalsAgainst: r.AwayScore,
+		Opponent:     string(r.AwayTeamID),
+	}
+	if !isHome {
+		result.GoalsFor, result.GoalsAgainst = r.AwayScore, r.HomeScore
+		result.Opponent = string(r.HomeTeamID)
+	}
+
+	switch {
+	case result.GoalsFor > result.GoalsAgainst:
+		result.Result = "W"
+	case result.GoalsFor < result.GoalsAgainst:
+		result.Result = "L"
+	default:
+		result.Result = "D"
+	}
+
+	return result
+}
+
+// ApplyResultMorale adjusts the morale of every player in a team's squad
+// after the match. Players who took the field feel the result most, a
+// derby counts like a result by an extra goal, and the captain softens the
+// swing for their teammates.
+func (r MatchResult) ApplyResultMorale(t *team.Team) {
+	outcome := r.TeamResult("", t.ID)
+	margin := outcome.GoalsFor - outcome.GoalsAgainst
+	if margin < 0 {
+		margin = -margin
+	}
+	if r.Derby {
+		margin++
+	}
+
+	featured := make(map[player.PlayerID]bool)
+	for _, a := range r.Appearances {
+		if a.TeamID == t.ID {
+			featured[a.PlayerID] = true
+		}
+	}
+
+	team.NewSquadManager(t).ApplyCaptaincyEffect(func(p *player.Player) {
+		p.ApplyResultMoraleByMargin(outcome.Result, featured[p.ID], margin)
+	})
+}
@@ -0,0 +1,198 @@
+// domain/match/events_test.go
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// newTestSquad creates a team holding the given players
+func newTestSquad(t *testing.T, id team.TeamID, players ...*player.Player) *team.Team {
+	t.Helper()
+	tm := team.NewTeam(id, "Team "+string(id), team.Stadium{Name: "Ground", Capacity: 20000})
+	for _, p := range players {
+		if err := tm.AddPlayer(*p); err != nil {
+			t.Fatalf("AddPlayer(%s): %v", p.ID, err)
+		}
+	}
+	return tm
+}
+
+// newTestPlayer creates a 25-year-old player in a position
+func newTestPlayer(id string, pos player.Position) *player.Player {
+	return player.NewPlayer(player.PlayerID(id), "Test", id, pos, time.Now().AddDate(-25, 0, -1))
+}
+
+func TestMatchCardsForSeparatesSecondYellows(t *testing.T) {
+	result := MatchResult{
+		Cards: []Card{
+			{Minute: 20, PlayerID: "a", Type: CardYellow},
+			{Minute: 60, PlayerID: "a", Type: CardYellow},
+			{Minute: 60, PlayerID: "a", Type: CardRed, SecondYellow: true},
+			{Minute: 70, PlayerID: "b", Type: CardYellow},
+			{Minute: 75, PlayerID: "b", Type: CardRed},
+		},
+	}
+
+	tests := []struct {
+		id   player.PlayerID
+		want player.MatchCards
+	}{
+		{"a", player.MatchCards{Yellow: 2, Red: 1, SecondYellow: 1}},
+		{"b", player.MatchCards{Yellow: 1, Red: 1}},
+		{"c", player.MatchCards{}},
+	}
+	for _, tt := range tests {
+		if got := result.MatchCardsFor(tt.id); got != tt.want {
+			t.Errorf("MatchCardsFor(%s) = %+v, want %+v", tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestRecordPartnerships(t *testing.T) {
+	tm := newTestSquad(t, "home",
+		newTestPlayer("a", player.PositionDEF),
+		newTestPlayer("b", player.PositionDEF),
+		newTestPlayer("c", player.PositionMID),
+	)
+	result := MatchResult{
+		HomeTeamID: "home",
+		AwayTeamID: "away",
+		Ratings:    map[player.PlayerID]float64{"a": 7, "b": 6.5, "x": 6},
+	}
+
+	result.RecordPartnerships(tm)
+	result.RecordPartnerships(tm)
+
+	tests := []struct {
+		a, b player.PlayerID
+		want int
+	}{
+		{"a", "b", 2},
+		{"a", "c", 0},
+		{"a", "x", 0},
+	}
+	for _, tt := range tests {
+		if got := tm.MatchesTogether(tt.a, tt.b); got != tt.want {
+			t.Errorf("MatchesTogether(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestApplyPlayerStatsRecordsGoalTypes(t *testing.T) {
+	tm := newTestSquad(t, "home", newTestPlayer("striker", player.PositionFWD), newTestPlayer("winger", player.PositionMID))
+
+	result := MatchResult{
+		HomeTeamID: "home",
+		AwayTeamID: "away",
+		HomeScore:  4,
+		Goals: []Goal{
+			{Minute: 10, TeamID: "home", PlayerID: "striker", Type: player.GoalPenalty},
+			{Minute: 30, TeamID: "home", PlayerID: "striker", AssistBy: "winger", Type: player.GoalHeader},
+			{Minute: 50, TeamID: "home", PlayerID: "striker", Type: player.GoalFreeKick},
+			{Minute: 70, TeamID: "home", PlayerID: "striker"}, // Type unknown
+		},
+		Appearances: []Appearance{
+			{PlayerID: "striker", TeamID: "home", Minutes: 90},
+			{PlayerID: "winger", TeamID: "home", Minutes: 90},
+		},
+		Ratings: map[player.PlayerID]float64{"striker": 9, "winger": 7},
+	}
+	if _, err := result.ApplyPlayerStats(tm); err != nil {
+		t.Fatalf("ApplyPlayerStats: %v", err)
+	}
+
+	striker, err := tm.GetPlayer("striker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := player.GoalBreakdown{OpenPlay: 1, Penalties: 1, FreeKicks: 1, Headers: 1}
+	if got := striker.GoalBreakdown(); got != want {
+		t.Errorf("GoalBreakdown() = %+v, want %+v", got, want)
+	}
+	if got := striker.CareerStats.TotalGoals; got != 4 {
+		t.Errorf("TotalGoals = %d, want 4", got)
+	}
+	if got := striker.CareerStats.CurrentSeason.Goals; got != 4 {
+		t.Errorf("season goals = %d, want 4", got)
+	}
+
+	winger, err := tm.GetPlayer("winger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := winger.CareerStats.TotalAssists; got != 1 {
+		t.Errorf("winger assists = %d, want 1", got)
+	}
+	if got := winger.GoalBreakdown().Total(); got != 0 {
+		t.Errorf("winger goals = %d, want 0", got)
+	}
+}
+
+func TestApplyResultMoraleBigWinVersusThrashing(t *testing.T) {
+	home := newTestSquad(t, "home", newTestPlayer("h-start", player.PositionMID), newTestPlayer("h-bench", player.PositionMID))
+	away := newTestSquad(t, "away", newTestPlayer("a-start", player.PositionMID), newTestPlayer("a-bench", player.PositionMID))
+	result := MatchResult{
+		HomeTeamID: "home",
+		AwayTeamID: "away",
+		HomeScore:  5,
+		Appearances: []Appearance{
+			{PlayerID: "h-start", TeamID: "home", Minutes: 90},
+			{PlayerID: "a-start", TeamID: "away", Minutes: 90},
+		},
+	}
+
+	morale := func(tm *team.Team, id player.PlayerID) float64 {
+		p, err := tm.GetPlayer(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return p.Morale
+	}
+	before := morale(home, "h-start")
+
+	result.ApplyResultMorale(home)
+	result.ApplyResultMorale(away)
+
+	starterLift, benchLift := morale(home, "h-start")-before, morale(home, "h-bench")-before
+	starterDrop, benchDrop := before-morale(away, "a-start"), before-morale(away, "a-bench")
+	if !(starterLift > benchLift && benchLift > 0) {
+		t.Errorf("5-0 win lifted the starter %.2f and the bench %.2f; want both up, the starter most", starterLift, benchLift)
+	}
+	if !(starterDrop > benchDrop && benchDrop > 0) {
+		t.Errorf("0-5 loss cost the starter %.2f and the bench %.2f; want both down, the starter most", starterDrop, benchDrop)
+	}
+}
+
+func TestApplyResultMoraleCaptainSoftensDefeat(t *testing.T) {
+	drop := func(captained bool) float64 {
+		captain := newTestPlayer("captain", player.PositionMID)
+		captain.CareerStats.TotalMatches = 400
+		tm := newTestSquad(t, "away", newTestPlayer("mate", player.PositionMID), captain)
+		if captained {
+			if err := tm.SetCaptain("captain"); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		before, err := tm.GetPlayer("mate")
+		if err != nil {
+			t.Fatal(err)
+		}
+		result := MatchResult{HomeTeamID: "home", AwayTeamID: "away", HomeScore: 3}
+		result.ApplyResultMorale(tm)
+
+		after, err := tm.GetPlayer("mate")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return before.Morale - after.Morale
+	}
+
+	if with, without := drop(true), drop(false); !(with > 0 && with < without) {
+		t.Errorf("defeat cost %.2f morale with a captain and %.2f without; want the captain to soften it", with, without)
+	}
+}
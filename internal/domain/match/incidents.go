@@ -0,0 +1,128 @@
+// domain/match/incidents.go
+package match
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// resolveBooking cautions a defending player, sending them off for a
+// second yellow or a straight red
+func (s *MatchState) resolveBooking(minute int, defending *side) {
+	offender := s.pickWeighted(defending, func(p *player.Player, pos player.Position) float64 {
+		if pos == player.PositionGK {
+			return 0
+		}
+		// Poor tacklers mistime challenges more often
+		return float64(110 - p.Attributes.Tackling)
+	}, "")
+	if offender == nil {
+		return
+	}
+
+	if s.rand.Float64() < straightRedRatio {
+		s.issueCard(minute, defending, offender, CardRed, false)
+		s.sendOff(defending, offender.ID)
+		return
+	}
+
+	alreadyBooked := s.result.CardsFor(offender.ID, CardYellow) > 0
+	s.issueCard(minute, defending, offender, CardYellow, false)
+	if alreadyBooked {
+		s.issueCard(minute, defending, offender, CardRed, true)
+		s.sendOff(defending, offender.ID)
+	}
+}
+
+// issueCard records a booking in the result and timeline
+func (s *MatchState) issueCard(minute int, sd *side, offender *player.Player, cardType CardType, secondYellow bool) {
+	s.result.Cards = append(s.result.Cards, Card{
+		Minute:       minute,
+		TeamID:       sd.team.ID,
+		PlayerID:     offender.ID,
+		Type:         cardType,
+		SecondYellow: secondYellow,
+	})
+	s.addEvent(MatchEvent{
+		Minute:    minute,
+		Type:      EventCard,
+		TeamID:    sd.team.ID,
+		PlayerIDs: []player.PlayerID{offender.ID},
+		Card:      cardType,
+	})
+}
+
+// sendOff removes a player, leaving the side a player short
+func (s *MatchState) sendOff(sd *side, playerID player.PlayerID) {
+	for i, p := range sd.players {
+		if p.ID == playerID {
+			s.result.Fitness[p.ID] = sd.fitness[i]
+			sd.removeSlot(i, s.minute)
+			return
+		}
+	}
+}
+
+// checkInjuries rolls for injuries based on each player's in-match fitness
+func (s *MatchState) checkInjuries(minute int, sd *side) {
+	for slot := 0; slot < len(sd.players); slot++ {
+		if sd.injured[slot] {
+			continue
+		}
+
+		// Assess risk against the player's current, not pre-match, fitness
+		current := *sd.players[slot]
+		current.Fitness = sd.fitness[slot]
+		risk := baseInjuryRisk + s.fitness.CalculateInjuryRisk(&current)/matchMinutes
+
+		if s.rand.Float64() >= risk {
+			continue
+		}
+
+		p := sd.players[slot]
+		sd.injured[slot] = true
+		diagnosis := player.RollInjuryWithSource(p, s.rand)
+		s.result.Injuries = append(s.result.Injuries, Injury{
+			Minute:        minute,
+			TeamID:        sd.team.ID,
+			PlayerID:      p.ID,
+			Type:          diagnosis.Type,
+			ExpectedDays:  diagnosis.Days,
+			AttributeLoss: diagnosis.AttributeLoss,
+		})
+		s.addEvent(MatchEvent{
+			Minute:    minute,
+			Type:      EventInjury,
+			TeamID:    sd.team.ID,
+			PlayerIDs: []player.PlayerID{p.ID},
+		})
+		sd.updateStrength()
+
+		if s.AutoSubstitutions && !s.replaceInjured(sd, slot) {
+			slot-- // The slot was vacated, so the next player shifted into it
+		}
+	}
+}
+
+// replaceInjured brings on the best bench player for an injured one,
+// withdrawing the player anyway when no replacement is possible
+func (s *MatchState) replaceInjured(sd *side, slot int) bool {
+	pos := sd.positions[slot]
+
+	best := -1
+	if s.SubstitutionsRemaining(sd.team.ID) > 0 {
+		for i, p := range sd.bench {
+			if best < 0 || ratingAtPosition(p, pos) > ratingAtPosition(sd.bench[best], pos) {
+				best = i
+			}
+		}
+	}
+
+	if best >= 0 {
+		s.substitute(sd, slot, best)
+		return true
+	}
+
+	s.result.Fitness[sd.players[slot].ID] = sd.fitness[slot]
+	sd.removeSlot(slot, s.minute)
+	return false
+}
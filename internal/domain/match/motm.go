@@ -0,0 +1,69 @@
+// domain/match/motm.go
+package match
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// Man of the match weighting on top of the match rating, which already
+// rewards goals, assists and clean sheets a little
+const (
+	motmGoalWeight       = 0.75
+	motmAssistWeight     = 0.4
+	motmCleanSheetWeight = 0.5
+)
+
+// ManOfTheMatch picks the standout performer from the match ratings,
+// favoring goals, assists and, for keepers and defenders, clean sheets.
+// Ties go to the player with more goals, then the higher rating, then the
+// lower player ID. Returns an empty ID when no one was rated.
+func (r MatchResult) ManOfTheMatch() player.PlayerID {
+	appearances := make(map[player.PlayerID]Appearance, len(r.Appearances))
+	for _, a := range r.Appearances {
+		appearances[a.PlayerID] = a
+	}
+
+	var best player.PlayerID
+	var bestScore float64
+	var bestGoals int
+
+	for id, rating := range r.Ratings {
+		goals := r.GoalsBy(id)
+		score := rating + float64(goals)*motmGoalWeight + float64(r.AssistsBy(id))*motmAssistWeight
+		if a, ok := appearances[id]; ok && r.keptCleanSheet(a) {
+			score += motmCleanSheetWeight
+		}
+
+		if best == "" || motmBeats(score, goals, rating, id, bestScore, bestGoals, r.Ratings[best], best) {
+			best, bestScore, bestGoals = id, score, goals
+		}
+	}
+
+	return best
+}
+
+// keptCleanSheet reports whether a keeper or defender's side conceded
+// nothing
+func (r MatchResult) keptCleanSheet(a Appearance) bool {
+	if a.Position != player.PositionGK && a.Position != player.PositionDEF {
+		return false
+	}
+	if a.TeamID == r.HomeTeamID {
+		return r.AwayScore == 0
+	}
+	return r.HomeScore == 0
+}
+
+// motmBeats orders man of the match candidates deterministically
+func motmBeats(score float64, goals int, rating float64, id player.PlayerID, bestScore float64, bestGoals int, bestRating float64, bestID player.PlayerID) bool {
+	switch {
+	case score != bestScore:
+		return score > bestScore
+	case goals != bestGoals:
+		return goals > bestGoals
+	case rating != bestRating:
+		return rating > bestRating
+	default:
+		return id < bestID
+	}
+}
@@ -0,0 +1,112 @@
+// domain/match/motm_test.go
+package match
+
+import (
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+func TestManOfTheMatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		awayScore   int
+		goals       []Goal
+		appearances []Appearance
+		ratings     map[player.PlayerID]float64
+		want        player.PlayerID
+	}{
+		{
+			name:  "hat-trick beats a higher-rated quiet teammate",
+			goals: []Goal{{PlayerID: "striker"}, {PlayerID: "striker"}, {PlayerID: "striker"}},
+			ratings: map[player.PlayerID]float64{
+				"striker":  8.0,
+				"anchor":   9.0,
+				"opponent": 6.0,
+			},
+			want: "striker",
+		},
+		{
+			name: "assists count",
+			goals: []Goal{
+				{PlayerID: "a", AssistBy: "creator"},
+				{PlayerID: "b", AssistBy: "creator"},
+				{PlayerID: "c", AssistBy: "creator"},
+			},
+			ratings: map[player.PlayerID]float64{"creator": 7.5, "anchor": 8.5},
+			want:    "creator",
+		},
+		{
+			name: "clean sheet lifts the keeper",
+			appearances: []Appearance{
+				{PlayerID: "keeper", TeamID: "home", Position: player.PositionGK},
+				{PlayerID: "anchor", TeamID: "home", Position: player.PositionMID},
+			},
+			ratings: map[player.PlayerID]float64{"keeper": 7.8, "anchor": 8.0},
+			want:    "keeper",
+		},
+		{
+			name:      "no clean sheet after conceding",
+			awayScore: 1,
+			goals:     []Goal{{TeamID: "away", PlayerID: "opponent"}},
+			appearances: []Appearance{
+				{PlayerID: "keeper", TeamID: "home", Position: player.PositionGK},
+				{PlayerID: "anchor", TeamID: "home", Position: player.PositionMID},
+			},
+			ratings: map[player.PlayerID]float64{"keeper": 7.8, "anchor": 8.0, "opponent": 6.0},
+			want:    "anchor",
+		},
+		{
+			name: "clean sheet only counts for keepers and defenders",
+			appearances: []Appearance{
+				{PlayerID: "defender", TeamID: "home", Position: player.PositionDEF},
+				{PlayerID: "winger", TeamID: "home", Position: player.PositionMID},
+			},
+			ratings: map[player.PlayerID]float64{"defender": 7.6, "winger": 7.9},
+			want:    "defender",
+		},
+		{
+			name:    "level score goes to the scorer",
+			goals:   []Goal{{PlayerID: "scorer"}},
+			ratings: map[player.PlayerID]float64{"scorer": 7.75, "anchor": 8.5},
+			want:    "scorer",
+		},
+		{
+			name: "level score and goals goes to the higher rating",
+			appearances: []Appearance{
+				{PlayerID: "keeper", TeamID: "home", Position: player.PositionGK},
+				{PlayerID: "anchor", TeamID: "home", Position: player.PositionMID},
+			},
+			ratings: map[player.PlayerID]float64{"keeper": 7.5, "anchor": 8.0},
+			want:    "anchor",
+		},
+		{
+			name:    "complete tie goes to the lower ID",
+			ratings: map[player.PlayerID]float64{"zed": 7.0, "amy": 7.0, "max": 7.0},
+			want:    "amy",
+		},
+		{
+			name: "no ratings",
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MatchResult{
+				HomeTeamID:  "home",
+				AwayTeamID:  "away",
+				HomeScore:   len(tt.goals) - tt.awayScore,
+				AwayScore:   tt.awayScore,
+				Goals:       tt.goals,
+				Appearances: tt.appearances,
+				Ratings:     tt.ratings,
+			}
+			for i := 0; i < 20; i++ { // Map order must not change the pick
+				if got := result.ManOfTheMatch(); got != tt.want {
+					t.Fatalf("ManOfTheMatch() = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// domain/match/rivalry_test.go
+package match
+
+import (
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// simulateFixtures plays the same fixture many times and returns the total
+// cards and the average full-time fitness of the starters
+func simulateFixtures(t *testing.T, derby bool) (int, float64) {
+	t.Helper()
+	home, homeLineup := newTestSide(t, "home", 0)
+	away, awayLineup := newTestSide(t, "away", 0)
+	if derby {
+		away.AddRival(home.ID)
+	}
+
+	const matches = 300
+	cards := 0
+	var fitness float64
+	samples := 0
+	for seed := int64(0); seed < matches; seed++ {
+		state := NewMatchState(home, away, homeLineup, awayLineup, seed)
+		state.PlayToEnd()
+		result := state.Result()
+
+		if result.Derby != derby {
+			t.Fatalf("Derby = %v, want %v", result.Derby, derby)
+		}
+		cards += len(result.Cards)
+		for _, id := range homeLineup.Starters {
+			if f, ok := result.Fitness[id]; ok {
+				fitness += f
+				samples++
+			}
+		}
+	}
+	return cards, fitness / float64(samples)
+}
+
+func TestDerbyRaisesCardsAndFatigue(t *testing.T) {
+	neutralCards, neutralFitness := simulateFixtures(t, false)
+	derbyCards, derbyFitness := simulateFixtures(t, true)
+
+	if float64(derbyCards) < float64(neutralCards)*1.2 {
+		t.Errorf("derby cards = %d, neutral = %d; want clearly more in derbies", derbyCards, neutralCards)
+	}
+	if derbyFitness >= neutralFitness {
+		t.Errorf("derby full-time fitness = %.1f, neutral = %.1f; want derbies more tiring", derbyFitness, neutralFitness)
+	}
+}
+
+func TestDerbyResultMoraleSwing(t *testing.T) {
+	tests := []struct {
+		name  string
+		derby bool
+	}{
+		{"neutral", false},
+		{"derby", true},
+	}
+
+	changes := map[bool]float64{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newTestSquad(t, "home", newTestPlayer("a", player.PositionMID))
+			morale := func() float64 {
+				p, err := tm.GetPlayer("a")
+				if err != nil {
+					t.Fatal(err)
+				}
+				return p.Morale
+			}
+			before := morale()
+
+			// A derby win counts like a win by an extra goal
+			result := MatchResult{
+				HomeTeamID:  "home",
+				AwayTeamID:  "away",
+				HomeScore:   2, // One goal short of a heavy win
+				Derby:       tt.derby,
+				Appearances: []Appearance{{PlayerID: "a", TeamID: "home", Minutes: 90}},
+			}
+			result.ApplyResultMorale(tm)
+			changes[tt.derby] = morale() - before
+		})
+	}
+
+	if changes[true] <= changes[false] {
+		t.Errorf("derby win morale gain = %.1f, neutral = %.1f; want a bigger swing in derbies", changes[true], changes[false])
+	}
+}
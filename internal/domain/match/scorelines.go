@@ -0,0 +1,46 @@
+// domain/match/scorelines.go
+package match
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// maxScorelineGoals is the most goals per side included in scoreline
+// probabilities; higher scores are rare enough to leave out
+const maxScorelineGoals = 6
+
+// ScoreProbabilities estimates the likelihood of each scoreline up to six
+// goals a side, keyed "home-away" (e.g. "2-1"), without simulating. Each
+// side's goals follow a Poisson distribution around the expected goals the
+// engine gives it at the home team's ground.
+func ScoreProbabilities(home, away *team.Team, homeLineup, awayLineup team.Lineup) map[string]float64 {
+	homeSide, awaySide := newMatchSides(home, away, homeLineup, awayLineup)
+	homeXG := expectedGoals(homeSide, awaySide)
+	awayXG := expectedGoals(awaySide, homeSide)
+
+	probabilities := make(map[string]float64, (maxScorelineGoals+1)*(maxScorelineGoals+1))
+	for h := 0; h <= maxScorelineGoals; h++ {
+		for a := 0; a <= maxScorelineGoals; a++ {
+			probabilities[fmt.Sprintf("%d-%d", h, a)] = poisson(homeXG, h) * poisson(awayXG, a)
+		}
+	}
+	return probabilities
+}
+
+// poisson returns the probability of exactly k events at an average rate
+func poisson(rate float64, k int) float64 {
+	if rate <= 0 {
+		if k == 0 {
+			return 1
+		}
+		return 0
+	}
+	logP := float64(k)*math.Log(rate) - rate
+	for i := 2; i <= k; i++ {
+		logP -= math.Log(float64(i))
+	}
+	return math.Exp(logP)
+}
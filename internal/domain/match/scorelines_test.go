@@ -0,0 +1,94 @@
+// domain/match/scorelines_test.go
+package match
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestPoisson(t *testing.T) {
+	tests := []struct {
+		name string
+		rate float64
+		k    int
+		want float64
+	}{
+		{"no rate, no events", 0, 0, 1},
+		{"no rate, some events", 0, 2, 0},
+		{"negative rate", -1, 0, 1},
+		{"none at rate 1", 1, 0, math.Exp(-1)},
+		{"one at rate 1", 1, 1, math.Exp(-1)},
+		{"three at rate 2", 2, 3, 8 * math.Exp(-2) / 6},
+		{"six at rate 1.5", 1.5, 6, math.Pow(1.5, 6) * math.Exp(-1.5) / 720},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := poisson(tt.rate, tt.k); math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("poisson(%.1f, %d) = %.6f, want %.6f", tt.rate, tt.k, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScoreProbabilitiesSumToOne(t *testing.T) {
+	home, homeLineup := newTestSide(t, "home", 0)
+	away, awayLineup := newTestSide(t, "away", 0)
+
+	probabilities := ScoreProbabilities(home, away, homeLineup, awayLineup)
+	if want := (maxScorelineGoals + 1) * (maxScorelineGoals + 1); len(probabilities) != want {
+		t.Fatalf("%d scorelines, want %d", len(probabilities), want)
+	}
+
+	total := 0.0
+	for h := 0; h <= maxScorelineGoals; h++ {
+		for a := 0; a <= maxScorelineGoals; a++ {
+			key := fmt.Sprintf("%d-%d", h, a)
+			p, ok := probabilities[key]
+			if !ok {
+				t.Fatalf("no probability for %s", key)
+			}
+			if p < 0 || p > 1 {
+				t.Errorf("P(%s) = %.4f, want within 0-1", key, p)
+			}
+			total += p
+		}
+	}
+	// The grid leaves out only scores above six goals a side
+	if total > 1+1e-9 || total < 0.99 {
+		t.Errorf("probabilities sum to %.4f, want about 1", total)
+	}
+}
+
+func TestScoreProbabilitiesMatchExpectedGoals(t *testing.T) {
+	home, homeLineup := newTestSide(t, "home", 0)
+	away, awayLineup := newTestSide(t, "away", 0)
+	homeSide, awaySide := newMatchSides(home, away, homeLineup, awayLineup)
+	homeXG, awayXG := expectedGoals(homeSide, awaySide), expectedGoals(awaySide, homeSide)
+
+	probabilities := ScoreProbabilities(home, away, homeLineup, awayLineup)
+	var homeGoals, awayGoals, homeWin, awayWin float64
+	for h := 0; h <= maxScorelineGoals; h++ {
+		for a := 0; a <= maxScorelineGoals; a++ {
+			p := probabilities[fmt.Sprintf("%d-%d", h, a)]
+			homeGoals += float64(h) * p
+			awayGoals += float64(a) * p
+			switch {
+			case h > a:
+				homeWin += p
+			case a > h:
+				awayWin += p
+			}
+		}
+	}
+
+	if math.Abs(homeGoals-homeXG) > 0.02 || math.Abs(awayGoals-awayXG) > 0.02 {
+		t.Errorf("expected score %.2f-%.2f, want the sides' xG %.2f-%.2f", homeGoals, awayGoals, homeXG, awayXG)
+	}
+	// The sides are identical, so only home advantage separates them
+	if homeXG <= awayXG || homeWin <= awayWin {
+		t.Errorf("evenly matched sides: xG %.2f-%.2f, win chances %.2f-%.2f; want the home side favored",
+			homeXG, awayXG, homeWin, awayWin)
+	}
+}
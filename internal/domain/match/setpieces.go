@@ -0,0 +1,100 @@
+// domain/match/setpieces.go
+package match
+
+import (
+	"math"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+const (
+	penaltyShare  = 0.01 // Share of chances that are penalties
+	freeKickShare = 0.08 // Share of chances that are shots from free kicks
+	maxHeaderRate = 0.5  // Share of a pure header's open-play goals headed in
+)
+
+// createChance picks the type of chance, who takes it and how likely it is
+// to go in. Set pieces go to the team's designated taker, or the next best
+// player on the pitch when they aren't on it.
+func (s *MatchState) createChance(attacking, defending *side) (*player.Player, player.GoalType, float64) {
+	roll := s.rand.Float64()
+
+	switch {
+	case roll < penaltyShare:
+		taker := setPieceTaker(attacking, attacking.setPieces.Penalty.PlayerID, penaltySkill)
+		return taker, player.GoalPenalty, penaltyConversion(taker, defending.keeper())
+	case roll < penaltyShare+freeKickShare:
+		taker := setPieceTaker(attacking, attacking.setPieces.FreeKick.PlayerID, freeKickSkill)
+		return taker, player.GoalFreeKick, freeKickConversion(taker, defending.keeper())
+	}
+
+	shooter := s.pickWeighted(attacking, func(p *player.Player, pos player.Position) float64 {
+		return scoringWeight(pos) * float64(p.Attributes.Shooting+p.Attributes.Heading/2)
+	}, "")
+	return shooter, player.GoalOpenPlay, conversionRate(attacking, defending)
+}
+
+// setPieceTaker returns the designated taker if they are playing outfield,
+// otherwise the best suited outfield player on the pitch
+func setPieceTaker(sd *side, designated player.PlayerID, skill func(*player.Player) float64) *player.Player {
+	for i, p := range sd.players {
+		if p.ID == designated && sd.positions[i] != player.PositionGK {
+			return p
+		}
+	}
+	return bestOnPitch(sd, skill)
+}
+
+// bestOnPitch returns the outfield player on the pitch with the highest
+// skill, or nil if there is none
+func bestOnPitch(sd *side, skill func(*player.Player) float64) *player.Player {
+	var best *player.Player
+	for i, p := range sd.players {
+		if sd.positions[i] == player.PositionGK {
+			continue
+		}
+		if best == nil || skill(p) > skill(best) {
+			best = p
+		}
+	}
+	return best
+}
+
+// keeper returns the side's goalkeeper on the pitch, or nil if none
+func (sd *side) keeper() *player.Player {
+	for i, p := range sd.players {
+		if sd.positions[i] == player.PositionGK {
+			return p
+		}
+	}
+	return nil
+}
+
+// freeKickSkill rates a player's free-kick technique
+func freeKickSkill(p *player.Player) float64 {
+	return team.FreeKickScore(p.Attributes)
+}
+
+// freeKickConversion compares the free-kick taker against the keeper
+func freeKickConversion(taker, keeper *player.Player) float64 {
+	skill := 40.0
+	if taker != nil {
+		skill = freeKickSkill(taker)
+	}
+	keeping := 40.0
+	if keeper != nil {
+		keeping = float64(keeper.Attributes.Keeping)
+	}
+
+	return math.Max(0.02, math.Min(0.07+(skill-keeping)/400, 0.15))
+}
+
+// headerShare is the chance an open-play goal by the player is a header
+func headerShare(p *player.Player) float64 {
+	total := float64(p.Attributes.Shooting + p.Attributes.Heading)
+	if total == 0 {
+		return 0
+	}
+	return maxHeaderRate * float64(p.Attributes.Heading) / total
+}
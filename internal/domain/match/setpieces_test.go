@@ -0,0 +1,55 @@
+// domain/match/setpieces_test.go
+package match
+
+import (
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+func TestSetPieceTakerFollowsDesignation(t *testing.T) {
+	// Shooting decides penalties, so the striker is the best taker in the
+	// squad and the midfielder the best of the rest
+	keeper := newTestPlayer("keeper", player.PositionGK)
+	keeper.Attributes.Shooting = 99
+	striker := newTestPlayer("striker", player.PositionFWD)
+	striker.Attributes.Shooting = 90
+	mid := newTestPlayer("mid", player.PositionMID)
+	mid.Attributes.Shooting = 70
+	back := newTestPlayer("back", player.PositionDEF)
+	back.Attributes.Shooting = 40
+
+	tm := newTestSquad(t, "home", keeper, striker, mid, back)
+	takers := team.NewSquadManager(tm).GetSetPieceTakers()
+	if takers.Penalty.PlayerID != "striker" {
+		t.Fatalf("designated penalty taker = %s, want striker", takers.Penalty.PlayerID)
+	}
+
+	tests := []struct {
+		name    string
+		players []*player.Player
+		want    player.PlayerID
+	}{
+		{"designated taker on the pitch", []*player.Player{keeper, back, mid, striker}, "striker"},
+		{"next best steps in", []*player.Player{keeper, back, mid}, "mid"},
+		{"only the keeper left", []*player.Player{keeper}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sd := &side{players: tt.players, setPieces: takers}
+			for _, p := range tt.players {
+				sd.positions = append(sd.positions, p.Position)
+			}
+
+			got := setPieceTaker(sd, sd.setPieces.Penalty.PlayerID, penaltySkill)
+			switch {
+			case tt.want == "" && got != nil:
+				t.Errorf("setPieceTaker() = %s, want nobody", got.ID)
+			case tt.want != "" && (got == nil || got.ID != tt.want):
+				t.Errorf("setPieceTaker() = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// domain/match/shootout.go
+package match
+
+import (
+	"math"
+	"sort"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+const shootoutRegulationKicks = 5
+
+// ShootoutKick records a single penalty in a shootout
+type ShootoutKick struct {
+	Round   int
+	Home    bool
+	TakerID player.PlayerID
+	Scored  bool
+}
+
+// ShootoutResult contains the outcome of a penalty shootout
+type ShootoutResult struct {
+	Kicks     []ShootoutKick
+	HomeScore int
+	AwayScore int
+	HomeWon   bool
+}
+
+// shootoutSide holds one team's takers and keeper
+type shootoutSide struct {
+	takers []*player.Player
+	keeper *player.Player
+	next   int
+}
+
+// ResolveShootout plays a penalty shootout with sudden death after five
+// kicks each, deterministic for a given seed
+func ResolveShootout(home, away team.Lineup, homePlayers, awayPlayers map[player.PlayerID]*player.Player, seed int64) ShootoutResult {
+	return ResolveShootoutWithSource(home, away, homePlayers, awayPlayers, common.NewRandSource(seed))
+}
+
+// ResolveShootoutWithSource plays a shootout like ResolveShootout, drawing
+// each kick from the given source
+func ResolveShootoutWithSource(home, away team.Lineup, homePlayers, awayPlayers map[player.PlayerID]*player.Player, rng common.RandSource) ShootoutResult {
+	sides := [2]*shootoutSide{
+		newShootoutSide(home, homePlayers),
+		newShootoutSide(away, awayPlayers),
+	}
+	scores := [2]int{}
+
+	result := ShootoutResult{Kicks: []ShootoutKick{}}
+
+	for round := 1; ; round++ {
+		for i, kicking := range sides {
+			// Home kicks first in each round
+			taker := kicking.nextTaker()
+			scored := rng.Float64() < penaltyConversion(taker, sides[1-i].keeper)
+			if scored {
+				scores[i]++
+			}
+
+			kick := ShootoutKick{Round: round, Home: i == 0, Scored: scored}
+			if taker != nil {
+				kick.TakerID = taker.ID
+			}
+			result.Kicks = append(result.Kicks, kick)
+
+			if shootoutDecided(scores, round, i == 0) {
+				result.HomeScore, result.AwayScore = scores[0], scores[1]
+				result.HomeWon = scores[0] > scores[1]
+				return result
+			}
+		}
+	}
+}
+
+// shootoutDecided checks whether either side can no longer be caught
+func shootoutDecided(scores [2]int, round int, homeJustKicked bool) bool {
+	if round > shootoutRegulationKicks {
+		// Sudden death is decided once both have kicked in the round
+		return !homeJustKicked && scores[0] != scores[1]
+	}
+
+	homeRemaining := shootoutRegulationKicks - round
+	awayRemaining := shootoutRegulationKicks - round
+	if homeJustKicked {
+		awayRemaining++
+	}
+
+	return scores[0]+homeRemaining < scores[1] || scores[1]+awayRemaining < scores[0]
+}
+
+// newShootoutSide orders takers by penalty ability, keeper last
+func newShootoutSide(lineup team.Lineup, players map[player.PlayerID]*player.Player) *shootoutSide {
+	ss := &shootoutSide{}
+
+	for i, id := range lineup.Starters {
+		p, ok := players[id]
+		if !ok || p == nil {
+			continue
+		}
+
+		isKeeper := p.Position == player.PositionGK
+		if i < len(lineup.Positions) {
+			isKeeper = lineup.Positions[i] == player.PositionGK
+		}
+		if isKeeper && ss.keeper == nil {
+			ss.keeper = p
+		}
+
+		ss.takers = append(ss.takers, p)
+	}
+
+	sort.SliceStable(ss.takers, func(i, j int) bool {
+		ki, kj := ss.takers[i] == ss.keeper, ss.takers[j] == ss.keeper
+		if ki != kj {
+			return kj
+		}
+		return penaltySkill(ss.takers[i]) > penaltySkill(ss.takers[j])
+	})
+
+	return ss
+}
+
+// nextTaker cycles through the takers in order
+func (ss *shootoutSide) nextTaker() *player.Player {
+	if len(ss.takers) == 0 {
+		return nil
+	}
+	taker := ss.takers[ss.next%len(ss.takers)]
+	ss.next++
+	return taker
+}
+
+// penaltySkill rates a player's ability from the spot
+func penaltySkill(p *player.Player) float64 {
+	return team.PenaltyScore(p.Attributes)
+}
+
+// penaltyConversion compares the taker against the keeper
+func penaltyConversion(taker, keeper *player.Player) float64 {
+	skill := 40.0
+	if taker != nil {
+		skill = penaltySkill(taker)
+	}
+	keeping := 40.0
+	if keeper != nil {
+		keeping = float64(keeper.Attributes.Keeping)
+	}
+
+	return math.Max(0.5, math.Min(0.75+(skill-keeping)/200, 0.95))
+}
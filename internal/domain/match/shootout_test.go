@@ -0,0 +1,123 @@
+// domain/match/shootout_test.go
+package match
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// scriptedKicks is a random source that scores or misses each kick in turn.
+// Penalties convert between 50% and 95% of the time, so a draw of 0 always
+// scores and one of 0.99 always misses.
+type scriptedKicks struct {
+	scored []bool
+	next   int
+}
+
+func (s *scriptedKicks) Float64() float64 {
+	scored := s.scored[s.next%len(s.scored)]
+	s.next++
+	if scored {
+		return 0
+	}
+	return 0.99
+}
+
+func (s *scriptedKicks) Intn(n int) int { return 0 }
+
+// newShootoutLineup returns a side's lineup and its players by ID
+func newShootoutLineup(t *testing.T, id team.TeamID) (team.Lineup, map[player.PlayerID]*player.Player) {
+	t.Helper()
+	tm, lineup := newTestSide(t, id, 0)
+	players := make(map[player.PlayerID]*player.Player)
+	for _, pid := range lineup.Starters {
+		p, err := tm.GetPlayer(pid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		players[pid] = p
+	}
+	return lineup, players
+}
+
+func TestResolveShootoutDeterministic(t *testing.T) {
+	home, homePlayers := newShootoutLineup(t, "home")
+	away, awayPlayers := newShootoutLineup(t, "away")
+
+	for seed := int64(1); seed <= 20; seed++ {
+		first := ResolveShootout(home, away, homePlayers, awayPlayers, seed)
+		second := ResolveShootout(home, away, homePlayers, awayPlayers, seed)
+		if !reflect.DeepEqual(first, second) {
+			t.Fatalf("seed %d: shootouts differ:\n%+v\n%+v", seed, first, second)
+		}
+	}
+}
+
+func TestResolveShootoutOutcomes(t *testing.T) {
+	home, homePlayers := newShootoutLineup(t, "home")
+	away, awayPlayers := newShootoutLineup(t, "away")
+
+	tests := []struct {
+		name      string
+		scored    []bool // Alternating home then away
+		wantKicks int
+		wantScore [2]int
+		wantHome  bool
+	}{
+		{
+			// 3-0 after three rounds, with two kicks left each
+			name:      "decided early",
+			scored:    []bool{true, false, true, false, true, false},
+			wantKicks: 6,
+			wantScore: [2]int{3, 0},
+			wantHome:  true,
+		},
+		{
+			// 1-3 down, home miss their fourth with one kick left
+			name:      "decided mid-round",
+			scored:    []bool{false, true, false, true, true, true, false},
+			wantKicks: 7,
+			wantScore: [2]int{1, 3},
+			wantHome:  false,
+		},
+		{
+			name: "sudden death",
+			scored: []bool{
+				true, true, true, true, true, true, true, true, true, true,
+				true, true, false, false, true, false,
+			},
+			wantKicks: 16,
+			wantScore: [2]int{7, 6},
+			wantHome:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ResolveShootoutWithSource(home, away, homePlayers, awayPlayers, &scriptedKicks{scored: tt.scored})
+
+			if len(got.Kicks) != tt.wantKicks {
+				t.Fatalf("%d kicks taken, want %d", len(got.Kicks), tt.wantKicks)
+			}
+			if got.HomeScore != tt.wantScore[0] || got.AwayScore != tt.wantScore[1] || got.HomeWon != tt.wantHome {
+				t.Errorf("shootout ended %d-%d, home won %v; want %d-%d, %v",
+					got.HomeScore, got.AwayScore, got.HomeWon, tt.wantScore[0], tt.wantScore[1], tt.wantHome)
+			}
+
+			for i, kick := range got.Kicks {
+				if kick.Home != (i%2 == 0) || kick.Round != i/2+1 {
+					t.Errorf("kick %d: round %d, home %v; want sides alternating from home in round %d", i, kick.Round, kick.Home, i/2+1)
+				}
+				if kick.Scored != tt.scored[i] {
+					t.Errorf("kick %d: scored %v, want %v", i, kick.Scored, tt.scored[i])
+				}
+				if kick.TakerID == "" {
+					t.Errorf("kick %d: no taker", i)
+				}
+			}
+		})
+	}
+}
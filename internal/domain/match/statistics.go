@@ -0,0 +1,187 @@
+// domain/match/statistics.go
+package match
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// CardType represents the colour of a booking
+type CardType string
+
+const (
+	CardYellow CardType = "yellow"
+	CardRed    CardType = "red"
+)
+
+// Goal records a goal scored in a match
+type Goal struct {
+	Minute   int
+	TeamID   team.TeamID
+	PlayerID player.PlayerID
+	AssistBy player.PlayerID // Empty when unassisted
+	Type     player.GoalType
+}
+
+// Card records a booking issued in a match
+type Card struct {
+	Minute       int
+	TeamID       team.TeamID
+	PlayerID     player.PlayerID
+	Type         CardType
+	SecondYellow bool // Set on a red shown for a second booking
+}
+
+// Injury records a player injured during a match
+type Injury struct {
+	Minute        int
+	TeamID        team.TeamID
+	PlayerID      player.PlayerID
+	Type          player.InjuryType
+	ExpectedDays  int
+	AttributeLoss map[string]int
+}
+
+// Substitution records a player change
+type Substitution struct {
+	Minute int
+	TeamID team.TeamID
+	Off    player.PlayerID
+	On     player.PlayerID
+}
+
+// Appearance records a player who took the field
+type Appearance struct {
+	PlayerID player.PlayerID
+	TeamID   team.TeamID
+	Position player.Position // Position played, not necessarily natural
+	Minutes  int
+}
+
+// TeamMatchStats tracks per-side match statistics
+type TeamMatchStats struct {
+	Shots         int
+	ShotsOnTarget int
+	Possession    float64 // percentage
+	ExpectedGoals float64 // Sum of the scoring probability of each shot
+}
+
+// MatchResult contains the outcome of a simulated match
+type MatchResult struct {
+	HomeTeamID team.TeamID
+	AwayTeamID team.TeamID
+	HomeScore  int
+	AwayScore  int
+	Derby      bool
+	Minutes    int             // Minutes played, including extra time
+	ExtraTime  bool            // Whether the match went to extra time
+	Shootout   *ShootoutResult // Set when a draw was settled on penalties
+
+	Goals         []Goal
+	Cards         []Card
+	Injuries      []Injury
+	Substitutions []Substitution
+	Appearances   []Appearance                // Everyone who played, home side first
+	Ratings       map[player.PlayerID]float64 // 1-10 match ratings
+	Fitness       map[player.PlayerID]float64 // Fitness when leaving the pitch
+
+	HomeStats TeamMatchStats
+	AwayStats TeamMatchStats
+}
+
+// IsDraw checks if the match ended level
+func (r MatchResult) IsDraw() bool {
+	return r.HomeScore == r.AwayScore
+}
+
+// Winner returns the winning team, or false for a draw. A draw settled
+// by a shootout is won by the shootout winner.
+func (r MatchResult) Winner() (team.TeamID, bool) {
+	switch {
+	case r.Shootout != nil && r.HomeScore == r.AwayScore:
+		if r.Shootout.HomeWon {
+			return r.HomeTeamID, true
+		}
+		return r.AwayTeamID, true
+	case r.HomeScore > r.AwayScore:
+		return r.HomeTeamID, true
+	case r.AwayScore > r.HomeScore:
+		return r.AwayTeamID, true
+	default:
+		return "", false
+	}
+}
+
+// GoalsBy counts goals scored by a player
+func (r MatchResult) GoalsBy(playerID player.PlayerID) int {
+	count := 0
+	for _, g := range r.Goals {
+		if g.PlayerID == playerID {
+			count++
+		}
+	}
+	return count
+}
+
+// GoalTypesBy lists the type of each goal scored by a player, in order
+func (r MatchResult) GoalTypesBy(playerID player.PlayerID) []player.GoalType {
+	types := []player.GoalType{}
+	for _, g := range r.Goals {
+		if g.PlayerID == playerID {
+			types = append(types, g.Type)
+		}
+	}
+	return types
+}
+
+// AssistsBy counts assists provided by a player
+func (r MatchResult) AssistsBy(playerID player.PlayerID) int {
+	count := 0
+	for _, g := range r.Goals {
+		if g.AssistBy == playerID {
+			count++
+		}
+	}
+	return count
+}
+
+// IsInjured checks if a player was injured in the match
+func (r MatchResult) IsInjured(playerID player.PlayerID) bool {
+	for _, i := range r.Injuries {
+		if i.PlayerID == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchCardsFor summarizes a player's bookings for their suspension record
+func (r MatchResult) MatchCardsFor(playerID player.PlayerID) player.MatchCards {
+	var cards player.MatchCards
+	for _, c := range r.Cards {
+		if c.PlayerID != playerID {
+			continue
+		}
+		switch c.Type {
+		case CardYellow:
+			cards.Yellow++
+		case CardRed:
+			cards.Red++
+			if c.SecondYellow {
+				cards.SecondYellow++
+			}
+		}
+	}
+	return cards
+}
+
+// CardsFor counts bookings of a given type for a player
+func (r MatchResult) CardsFor(playerID player.PlayerID, cardType CardType) int {
+	count := 0
+	for _, c := range r.Cards {
+		if c.PlayerID == playerID && c.Type == cardType {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,142 @@
+// domain/match/substitution.go
+package match
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// ApplySubstitution replaces an on-pitch player with a bench player
+func ApplySubstitution(state *MatchState, off, on player.PlayerID) error {
+	if state.finished {
+		return common.ErrInvalidSubstitution.WithDetails(map[string]interface{}{
+			"reason": "match finished",
+		})
+	}
+
+	sd, slot := state.findOnPitch(off)
+	if sd == nil {
+		return common.ErrInvalidSubstitution.WithDetails(map[string]interface{}{
+			"player_id": string(off),
+			"reason":    "player not on the pitch",
+		})
+	}
+
+	benchIdx := -1
+	for i, p := range sd.bench {
+		if p.ID == on {
+			benchIdx = i
+			break
+		}
+	}
+	if benchIdx < 0 {
+		return common.ErrInvalidSubstitution.WithDetails(map[string]interface{}{
+			"player_id": string(on),
+			"reason":    "player not on the bench",
+		})
+	}
+
+	if state.SubstitutionsRemaining(sd.team.ID) <= 0 {
+		return common.ErrSubstitutionLimit.WithDetails(map[string]interface{}{
+			"team_id": string(sd.team.ID),
+			"limit":   state.MaxSubstitutions,
+		})
+	}
+
+	state.substitute(sd, slot, benchIdx)
+	return nil
+}
+
+// SubstitutionsRemaining returns how many changes a team can still make
+func (s *MatchState) SubstitutionsRemaining(teamID team.TeamID) int {
+	sd := s.sideFor(teamID)
+	if sd == nil {
+		return 0
+	}
+	return s.MaxSubstitutions - sd.subsUsed
+}
+
+// substitute swaps a bench player into a pitch slot. The outgoing player's
+// fitness is frozen at the moment they leave.
+func (s *MatchState) substitute(sd *side, slot, benchIdx int) {
+	off := sd.players[slot]
+	on := sd.bench[benchIdx]
+
+	s.result.Fitness[off.ID] = sd.fitness[slot]
+	sd.markOff(off.ID, s.minute)
+
+	sd.players[slot] = on
+	sd.entered[slot] = s.minute
+	sd.fitness[slot] = on.Fitness
+	sd.tired[slot] = false
+	sd.injured[slot] = false
+	sd.bench = append(sd.bench[:benchIdx], sd.bench[benchIdx+1:]...)
+	sd.appeared = append(sd.appeared, appearance{player: on, position: sd.positions[slot], on: s.minute, off: -1})
+	sd.subsUsed++
+	sd.updateStrength()
+
+	s.result.Substitutions = append(s.result.Substitutions, Substitution{
+		Minute: s.minute,
+		TeamID: sd.team.ID,
+		Off:    off.ID,
+		On:     on.ID,
+	})
+	s.addEvent(MatchEvent{
+		Minute:    s.minute,
+		Type:      EventSubstitution,
+		TeamID:    sd.team.ID,
+		PlayerIDs: []player.PlayerID{off.ID, on.ID},
+	})
+}
+
+// autoSubstitute replaces tired players with the best fresh bench option
+func (s *MatchState) autoSubstitute(sd *side) {
+	for slot := range sd.players {
+		if s.SubstitutionsRemaining(sd.team.ID) <= 0 {
+			return
+		}
+		if !sd.tired[slot] {
+			continue
+		}
+
+		pos := sd.positions[slot]
+		best := -1
+		for i, p := range sd.bench {
+			if !p.CanPlayPosition(pos) || p.Fitness <= sd.fitness[slot] {
+				continue
+			}
+			if best < 0 || ratingAtPosition(p, pos) > ratingAtPosition(sd.bench[best], pos) {
+				best = i
+			}
+		}
+
+		if best >= 0 {
+			s.substitute(sd, slot, best)
+		}
+	}
+}
+
+// findOnPitch locates a player currently on the pitch
+func (s *MatchState) findOnPitch(playerID player.PlayerID) (*side, int) {
+	for _, sd := range []*side{s.home, s.away} {
+		for i, p := range sd.players {
+			if p.ID == playerID {
+				return sd, i
+			}
+		}
+	}
+	return nil, -1
+}
+
+// sideFor returns the side belonging to a team
+func (s *MatchState) sideFor(teamID team.TeamID) *side {
+	switch teamID {
+	case s.home.team.ID:
+		return s.home
+	case s.away.team.ID:
+		return s.away
+	default:
+		return nil
+	}
+}
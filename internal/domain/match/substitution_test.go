@@ -0,0 +1,149 @@
+// domain/match/substitution_test.go
+package match
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// newTestSide creates a team with a 4-4-2 lineup and the given number of
+// midfielders on the bench
+func newTestSide(t *testing.T, id team.TeamID, bench int) (*team.Team, team.Lineup) {
+	t.Helper()
+
+	lineup := team.Lineup{Formation: team.Formation442}
+	players := []*player.Player{}
+	add := func(pos player.Position, n int, starting bool) {
+		for i := 0; i < n; i++ {
+			name := fmt.Sprintf("%s-%s%d", id, pos, i)
+			if !starting {
+				name = fmt.Sprintf("%s-SUB%d", id, i)
+			}
+			p := newTestPlayer(name, pos)
+			players = append(players, p)
+			if starting {
+				lineup.Starters = append(lineup.Starters, p.ID)
+				lineup.Positions = append(lineup.Positions, pos)
+			} else {
+				lineup.Substitutes = append(lineup.Substitutes, p.ID)
+			}
+		}
+	}
+	add(player.PositionGK, 1, true)
+	add(player.PositionDEF, 4, true)
+	add(player.PositionMID, 4, true)
+	add(player.PositionFWD, 2, true)
+	add(player.PositionMID, bench, false)
+
+	return newTestSquad(t, id, players...), lineup
+}
+
+// newTestMatchState prepares a manually managed match between two sides
+func newTestMatchState(t *testing.T, bench int) *MatchState {
+	t.Helper()
+	home, homeLineup := newTestSide(t, "home", bench)
+	away, awayLineup := newTestSide(t, "away", bench)
+	return NewMatchState(home, away, homeLineup, awayLineup, 1)
+}
+
+func TestApplySubstitutionValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		off, on player.PlayerID
+		finish  bool
+		wantErr error
+	}{
+		{"valid change", "home-MID0", "home-SUB0", false, nil},
+		{"off player not on the pitch", "home-SUB1", "home-SUB0", false, common.ErrInvalidSubstitution},
+		{"on player not on the bench", "home-MID0", "home-MID1", false, common.ErrInvalidSubstitution},
+		{"bench player of the other side", "home-MID0", "away-SUB0", false, common.ErrInvalidSubstitution},
+		{"match finished", "home-MID0", "home-SUB0", true, common.ErrInvalidSubstitution},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := newTestMatchState(t, 3)
+			if tt.finish {
+				state.PlayToEnd()
+			}
+
+			err := ApplySubstitution(state, tt.off, tt.on)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("ApplySubstitution: %v", err)
+				}
+				if got := state.SubstitutionsRemaining("home"); got != maxSubstitutions-1 {
+					t.Errorf("SubstitutionsRemaining = %d, want %d", got, maxSubstitutions-1)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ApplySubstitution = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestApplySubstitutionLimit(t *testing.T) {
+	state := newTestMatchState(t, 4)
+	state.MaxSubstitutions = 3
+
+	for i := 0; i < 3; i++ {
+		off := player.PlayerID(fmt.Sprintf("home-MID%d", i))
+		on := player.PlayerID(fmt.Sprintf("home-SUB%d", i))
+		if err := ApplySubstitution(state, off, on); err != nil {
+			t.Fatalf("substitution %d: %v", i+1, err)
+		}
+	}
+
+	err := ApplySubstitution(state, "home-MID3", "home-SUB3")
+	if !errors.Is(err, common.ErrSubstitutionLimit) {
+		t.Fatalf("fourth substitution = %v, want %v", err, common.ErrSubstitutionLimit)
+	}
+	if got := state.SubstitutionsRemaining("home"); got != 0 {
+		t.Errorf("home SubstitutionsRemaining = %d, want 0", got)
+	}
+	if got := state.SubstitutionsRemaining("away"); got != 3 {
+		t.Errorf("away SubstitutionsRemaining = %d, want 3", got)
+	}
+	if got := state.SubstitutionsRemaining("nobody"); got != 0 {
+		t.Errorf("unknown team SubstitutionsRemaining = %d, want 0", got)
+	}
+}
+
+func TestSubstitutedPlayerStopsTiring(t *testing.T) {
+	state := newTestMatchState(t, 1)
+	for state.Minute() < 45 {
+		state.PlayMinute()
+	}
+
+	home := state.sideFor("home")
+	_, slot := state.findOnPitch("home-MID0")
+	frozen := home.fitness[slot]
+
+	if err := ApplySubstitution(state, "home-MID0", "home-SUB0"); err != nil {
+		t.Fatalf("ApplySubstitution: %v", err)
+	}
+	state.PlayToEnd()
+	result := state.Result()
+
+	if got := result.Fitness["home-MID0"]; got != frozen {
+		t.Errorf("substituted player's fitness = %.2f, want %.2f at the time of the change", got, frozen)
+	}
+	if got := result.Fitness["home-MID1"]; got >= frozen {
+		t.Errorf("player who stayed on finished at %.2f, want below %.2f", got, frozen)
+	}
+
+	minutes := map[player.PlayerID]int{}
+	for _, a := range result.Appearances {
+		minutes[a.PlayerID] = a.Minutes
+	}
+	if minutes["home-MID0"] != 45 || minutes["home-SUB0"] != result.Minutes-45 {
+		t.Errorf("minutes off = %d, on = %d; want 45 and %d", minutes["home-MID0"], minutes["home-SUB0"], result.Minutes-45)
+	}
+}
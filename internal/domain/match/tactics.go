@@ -0,0 +1,84 @@
+// domain/match/tactics.go
+package match
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// lineStrength summarizes a lineup's quality by area of the pitch
+type lineStrength struct {
+	Goalkeeping float64
+	Defense     float64
+	Midfield    float64
+	Attack      float64
+}
+
+// attackQuality blends the lines involved in creating and finishing chances
+func (ls lineStrength) attackQuality() float64 {
+	return ls.Attack*0.6 + ls.Midfield*0.4
+}
+
+// defenseQuality blends the lines involved in stopping chances
+func (ls lineStrength) defenseQuality() float64 {
+	return ls.Defense*0.7 + ls.Goalkeeping*0.3
+}
+
+// ratingAtPosition rates a player for the position assigned in the lineup
+func ratingAtPosition(p *player.Player, pos player.Position) float64 {
+	return float64(p.GetRatingAtPosition(pos))
+}
+
+// calculateLineStrength averages the starters' ratings for each line,
+// scaled by a per-player effectiveness modifier (nil means unmodified)
+func calculateLineStrength(players []*player.Player, positions []player.Position, modifiers []float64) lineStrength {
+	totals := make(map[player.Position]float64)
+	counts := make(map[player.Position]int)
+
+	for i, p := range players {
+		pos := positions[i]
+		rating := ratingAtPosition(p, pos)
+		if modifiers != nil {
+			rating *= modifiers[i]
+		}
+		totals[pos] += rating
+		counts[pos]++
+	}
+
+	average := func(pos player.Position) float64 {
+		if counts[pos] == 0 {
+			return 30 // An empty line is badly exposed
+		}
+		return totals[pos] / float64(counts[pos])
+	}
+
+	return lineStrength{
+		Goalkeeping: average(player.PositionGK),
+		Defense:     average(player.PositionDEF),
+		Midfield:    average(player.PositionMID),
+		Attack:      average(player.PositionFWD),
+	}
+}
+
+// resolveLineup looks up the lineup's starters and their assigned positions
+func resolveLineup(t *team.Team, lineup team.Lineup) ([]*player.Player, []player.Position) {
+	players := []*player.Player{}
+	positions := []player.Position{}
+
+	for i, id := range lineup.Starters {
+		p, err := t.GetPlayer(id)
+		if err != nil {
+			continue
+		}
+
+		pos := p.Position
+		if i < len(lineup.Positions) {
+			pos = lineup.Positions[i]
+		}
+
+		players = append(players, p)
+		positions = append(positions, pos)
+	}
+
+	return players, positions
+}
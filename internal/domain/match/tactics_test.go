@@ -0,0 +1,52 @@
+// domain/match/tactics_test.go
+package match
+
+import (
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// playWithTactics plays the same fixture many times with the home side set
+// up in a preset, returning the home side's cards and the average
+// full-time fitness of its starters
+func playWithTactics(t *testing.T, preset team.TacticsPreset) (int, float64) {
+	t.Helper()
+	home, homeLineup := newTestSide(t, "home", 0)
+	away, awayLineup := newTestSide(t, "away", 0)
+	home.Tactics = team.PresetTactics(preset)
+
+	cards := 0
+	var fitness float64
+	samples := 0
+	for seed := int64(0); seed < 300; seed++ {
+		result := Simulate(home, away, homeLineup, awayLineup, seed)
+		for _, c := range result.Cards {
+			if c.TeamID == home.ID {
+				cards++
+			}
+		}
+		for _, id := range homeLineup.Starters {
+			if f, ok := result.Fitness[id]; ok {
+				fitness += f
+				samples++
+			}
+		}
+	}
+	return cards, fitness / float64(samples)
+}
+
+func TestTacticsDriveFatigueAndCards(t *testing.T) {
+	lowBlockCards, lowBlockFitness := playWithTactics(t, team.PresetDefensive)
+	balancedCards, balancedFitness := playWithTactics(t, team.PresetBalanced)
+	pressCards, pressFitness := playWithTactics(t, team.PresetHighPress)
+
+	if !(pressFitness < balancedFitness && balancedFitness < lowBlockFitness) {
+		t.Errorf("full-time fitness: high press %.1f, balanced %.1f, low block %.1f; want pressing most tiring",
+			pressFitness, balancedFitness, lowBlockFitness)
+	}
+	if !(pressCards > balancedCards && balancedCards > lowBlockCards) {
+		t.Errorf("cards: high press %d, balanced %d, low block %d; want pressing booked most",
+			pressCards, balancedCards, lowBlockCards)
+	}
+}
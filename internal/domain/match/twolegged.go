@@ -0,0 +1,120 @@
+// domain/match/twolegged.go
+package match
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// TieDecider records how a two-legged tie was settled
+type TieDecider string
+
+const (
+	DecidedOnAggregate TieDecider = "aggregate"
+	DecidedOnAwayGoals TieDecider = "away_goals"
+	DecidedOnShootout  TieDecider = "shootout"
+	Undecided          TieDecider = ""
+)
+
+// TieRules set how a two-legged tie level on aggregate is settled
+type TieRules struct {
+	AwayGoals        bool // More away goals wins a level aggregate
+	ExtraTimeMinutes int  // Played in the second leg when still level; 0 for none
+	Shootout         bool // Penalties if still level after extra time
+}
+
+// DefaultTieRules returns the modern rules: no away goals, with extra time
+// and penalties in the second leg
+func DefaultTieRules() TieRules {
+	return TieRules{
+		ExtraTimeMinutes: defaultExtraTime,
+		Shootout:         true,
+	}
+}
+
+// AwayGoalsTieRules returns the traditional rules, where away goals settle
+// a level aggregate before extra time and penalties
+func AwayGoalsTieRules() TieRules {
+	rules := DefaultTieRules()
+	rules.AwayGoals = true
+	return rules
+}
+
+// SecondLegConfig returns the config for the second leg of a tie, which
+// goes to extra time and penalties only if the tie is level under the rules
+func SecondLegConfig(firstLeg MatchResult, rules TieRules) MatchConfig {
+	return MatchConfig{
+		RegulationMinutes: matchMinutes,
+		ExtraTimeMinutes:  rules.ExtraTimeMinutes,
+		ShootoutOnDraw:    rules.Shootout,
+		FirstLeg:          &firstLeg,
+		AwayGoals:         rules.AwayGoals,
+	}
+}
+
+// TieOutcome is the result of a two-legged tie. The first team is the
+// home side in the first leg.
+type TieOutcome struct {
+	FirstTeam       team.TeamID
+	SecondTeam      team.TeamID
+	FirstAggregate  int
+	SecondAggregate int
+	FirstAwayGoals  int
+	SecondAwayGoals int
+	Winner          team.TeamID // Empty while undecided
+	DecidedBy       TieDecider
+}
+
+// Decided reports whether a team has advanced
+func (o TieOutcome) Decided() bool {
+	return o.Winner != ""
+}
+
+// ResolveTwoLegged settles a two-legged tie under the default rules
+func ResolveTwoLegged(leg1, leg2 MatchResult) TieOutcome {
+	return ResolveTwoLeggedWithRules(leg1, leg2, DefaultTieRules())
+}
+
+// ResolveTwoLeggedWithRules settles a two-legged tie: the higher aggregate
+// advances, then if enabled the side with more away goals, then the
+// winner of a second-leg shootout. Extra-time goals count towards the
+// aggregate and as away goals. The tie stays undecided if it is still
+// level or the legs are not between the same teams with venues swapped.
+func ResolveTwoLeggedWithRules(leg1, leg2 MatchResult, rules TieRules) TieOutcome {
+	outcome := TieOutcome{
+		FirstTeam:  leg1.HomeTeamID,
+		SecondTeam: leg1.AwayTeamID,
+	}
+	if leg2.HomeTeamID != leg1.AwayTeamID || leg2.AwayTeamID != leg1.HomeTeamID {
+		return outcome
+	}
+
+	outcome.FirstAggregate = leg1.HomeScore + leg2.AwayScore
+	outcome.SecondAggregate = leg1.AwayScore + leg2.HomeScore
+	outcome.FirstAwayGoals = leg2.AwayScore
+	outcome.SecondAwayGoals = leg1.AwayScore
+
+	switch {
+	case outcome.FirstAggregate != outcome.SecondAggregate:
+		outcome.DecidedBy = DecidedOnAggregate
+		outcome.Winner = outcome.leader(outcome.FirstAggregate, outcome.SecondAggregate)
+	case rules.AwayGoals && outcome.FirstAwayGoals != outcome.SecondAwayGoals:
+		outcome.DecidedBy = DecidedOnAwayGoals
+		outcome.Winner = outcome.leader(outcome.FirstAwayGoals, outcome.SecondAwayGoals)
+	case leg2.Shootout != nil:
+		outcome.DecidedBy = DecidedOnShootout
+		outcome.Winner = leg2.AwayTeamID
+		if leg2.Shootout.HomeWon {
+			outcome.Winner = leg2.HomeTeamID
+		}
+	}
+
+	return outcome
+}
+
+// leader returns the team ahead on a pair of first/second team counts
+func (o TieOutcome) leader(first, second int) team.TeamID {
+	if first > second {
+		return o.FirstTeam
+	}
+	return o.SecondTeam
+}
@@ -0,0 +1,121 @@
+// domain/match/twolegged_test.go
+package match
+
+import (
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// legs builds a tie between a and b: a hosts the first leg, b the second
+func legs(first, second [2]int, shootout *ShootoutResult) (MatchResult, MatchResult) {
+	leg1 := MatchResult{HomeTeamID: "a", AwayTeamID: "b", HomeScore: first[0], AwayScore: first[1]}
+	leg2 := MatchResult{HomeTeamID: "b", AwayTeamID: "a", HomeScore: second[0], AwayScore: second[1], Shootout: shootout}
+	return leg1, leg2
+}
+
+func TestResolveTwoLeggedTieBreakOrder(t *testing.T) {
+	bWinsShootout := &ShootoutResult{HomeScore: 4, AwayScore: 3, HomeWon: true}
+	aWinsShootout := &ShootoutResult{HomeScore: 2, AwayScore: 4}
+
+	tests := []struct {
+		name          string
+		first, second [2]int // Home-away score of each leg
+		shootout      *ShootoutResult
+		rules         TieRules
+		wantWinner    team.TeamID
+		wantDecider   TieDecider
+	}{
+		{"aggregate win", [2]int{2, 0}, [2]int{1, 0}, nil, DefaultTieRules(), "a", DecidedOnAggregate},
+		{"aggregate beats away goals", [2]int{3, 2}, [2]int{1, 1}, nil, AwayGoalsTieRules(), "a", DecidedOnAggregate},
+		{"aggregate beats a recorded shootout", [2]int{0, 1}, [2]int{1, 1}, aWinsShootout, DefaultTieRules(), "b", DecidedOnAggregate},
+		{"away goals decider", [2]int{1, 1}, [2]int{2, 2}, nil, AwayGoalsTieRules(), "a", DecidedOnAwayGoals},
+		{"away goals before a shootout", [2]int{2, 2}, [2]int{1, 1}, aWinsShootout, AwayGoalsTieRules(), "b", DecidedOnAwayGoals},
+		{"away goals ignored by default", [2]int{1, 1}, [2]int{2, 2}, bWinsShootout, DefaultTieRules(), "b", DecidedOnShootout},
+		{"level away goals go to a shootout", [2]int{1, 1}, [2]int{1, 1}, aWinsShootout, AwayGoalsTieRules(), "a", DecidedOnShootout},
+		{"aggregate-level shootout", [2]int{2, 0}, [2]int{2, 0}, bWinsShootout, DefaultTieRules(), "b", DecidedOnShootout},
+		{"level without a shootout", [2]int{1, 0}, [2]int{1, 0}, nil, DefaultTieRules(), "", Undecided},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leg1, leg2 := legs(tt.first, tt.second, tt.shootout)
+			got := ResolveTwoLeggedWithRules(leg1, leg2, tt.rules)
+			if got.Winner != tt.wantWinner || got.DecidedBy != tt.wantDecider {
+				t.Errorf("winner %q by %q, want %q by %q", got.Winner, got.DecidedBy, tt.wantWinner, tt.wantDecider)
+			}
+			if got.Decided() != (tt.wantWinner != "") {
+				t.Errorf("Decided() = %v with winner %q", got.Decided(), got.Winner)
+			}
+		})
+	}
+}
+
+func TestResolveTwoLeggedTotals(t *testing.T) {
+	leg1, leg2 := legs([2]int{3, 1}, [2]int{2, 1}, nil)
+	got := ResolveTwoLegged(leg1, leg2)
+
+	want := TieOutcome{
+		FirstTeam:       "a",
+		SecondTeam:      "b",
+		FirstAggregate:  4,
+		SecondAggregate: 3,
+		FirstAwayGoals:  1,
+		SecondAwayGoals: 1,
+		Winner:          "a",
+		DecidedBy:       DecidedOnAggregate,
+	}
+	if got != want {
+		t.Errorf("ResolveTwoLegged() = %+v, want %+v", got, want)
+	}
+}
+
+func TestResolveTwoLeggedMismatchedLegs(t *testing.T) {
+	leg1, _ := legs([2]int{3, 0}, [2]int{0, 0}, nil)
+	tests := []struct {
+		name string
+		leg2 MatchResult
+	}{
+		{"venues not swapped", MatchResult{HomeTeamID: "a", AwayTeamID: "b"}},
+		{"different opponent", MatchResult{HomeTeamID: "c", AwayTeamID: "a"}},
+		{"same venue twice", leg1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveTwoLegged(leg1, tt.leg2); got.Decided() || got.FirstAggregate != 0 {
+				t.Errorf("ResolveTwoLegged() = %+v, want an undecided tie with no totals", got)
+			}
+		})
+	}
+}
+
+func TestSecondLegPlaysOnWhenAggregateLevel(t *testing.T) {
+	home, homeLineup := newTestSide(t, "home", 0)
+	away, awayLineup := newTestSide(t, "away", 0)
+	// The second leg's home side lost the first leg 1-0 away
+	leg1 := MatchResult{HomeTeamID: "away", AwayTeamID: "home", HomeScore: 1}
+
+	for _, rules := range []TieRules{DefaultTieRules(), AwayGoalsTieRules()} {
+		extraTime := 0
+		for seed := int64(0); seed < configSeeds; seed++ {
+			leg2 := SimulateWithConfig(home, away, homeLineup, awayLineup, seed, SecondLegConfig(leg1, rules))
+			outcome := ResolveTwoLeggedWithRules(leg1, leg2, rules)
+
+			if !outcome.Decided() {
+				t.Fatalf("away goals %v, seed %d: tie undecided after %d-%d", rules.AwayGoals, seed, leg2.HomeScore, leg2.AwayScore)
+			}
+			if leg2.ExtraTime {
+				extraTime++
+			} else if outcome.DecidedBy == DecidedOnShootout {
+				t.Errorf("away goals %v, seed %d: shootout without extra time", rules.AwayGoals, seed)
+			}
+			if leg2.Shootout != nil && outcome.DecidedBy != DecidedOnShootout {
+				t.Errorf("away goals %v, seed %d: shootout taken in a tie settled by %s", rules.AwayGoals, seed, outcome.DecidedBy)
+			}
+		}
+		if extraTime == 0 {
+			t.Errorf("away goals %v: no second leg went to extra time", rules.AwayGoals)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// domain/match/xg.go
+package match
+
+import (
+	"math"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// ExpectedGoals estimates a team's expected goals against an opponent on
+// neutral ground. The opponent is assumed to field its strongest lineup in
+// its current formation.
+func ExpectedGoals(t *team.Team, lineup team.Lineup, opponent *team.Team) float64 {
+	opponentLineup := probableLineup(opponent)
+
+	attacking := newSide(t, lineup, false)
+	defending := newSide(opponent, opponentLineup, false)
+	attacking.formation = lineup.Formation.GetFormationStrength(opponentLineup.Formation)
+	defending.formation = opponentLineup.Formation.GetFormationStrength(lineup.Formation)
+
+	return expectedGoals(attacking, defending)
+}
+
+// expectedGoals is the number of goals the engine expects a side to score
+// over a match, given the chance of controlling each minute, of shooting
+// while in control, and of converting the shot
+func expectedGoals(attacking, defending *side) float64 {
+	return matchMinutes * possessionShare(attacking, defending) * shotChance * conversionRate(attacking, defending)
+}
+
+// possessionShare returns a side's chance of controlling a minute
+func possessionShare(sd, opponent *side) float64 {
+	own := sd.strength.Midfield * sd.formation * sd.venueBoost()
+	other := opponent.strength.Midfield * opponent.formation * opponent.venueBoost()
+	if own+other == 0 {
+		return 0.5
+	}
+	return own / (own + other)
+}
+
+// conversionRate compares attacking quality against the opposing defense
+func conversionRate(attacking, defending *side) float64 {
+	attack := attacking.strength.attackQuality() * attacking.formation * attacking.venueBoost()
+	defense := defending.strength.defenseQuality() * defending.formation
+	if defense <= 0 {
+		defense = 1
+	}
+
+	rate := baseConversion * math.Pow(attack/defense, 2)
+	return math.Max(0.03, math.Min(rate, 0.35))
+}
+
+// venueBoost returns the home advantage multiplier for a side
+func (sd *side) venueBoost() float64 {
+	if sd.isHome {
+		return homeAdvantage
+	}
+	return 1.0
+}
+
+// probableLineup predicts the lineup a team will field
+func probableLineup(t *team.Team) team.Lineup {
+	if lineup, err := team.NewSquadManager(t).RecommendLineup(t.Formation); err == nil {
+		return *lineup
+	}
+
+	// Short of a full lineup, field whoever is available in their natural roles
+	lineup := team.Lineup{Formation: t.Formation}
+	for _, p := range t.GetAvailablePlayers() {
+		if len(lineup.Starters) == 11 {
+			break
+		}
+		lineup.Starters = append(lineup.Starters, p.ID)
+		lineup.Positions = append(lineup.Positions, p.Position)
+	}
+	return lineup
+}
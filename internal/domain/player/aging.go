@@ -0,0 +1,88 @@
+// domain/player/aging.go
+package player
+
+import (
+	"math"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+const (
+	// developmentTicksPerSeason is how many natural development steps a
+	// season of training and matches amounts to
+	developmentTicksPerSeason = 12
+)
+
+// SeasonAdvance reports what happened to a player over a season
+type SeasonAdvance struct {
+	RatingChange int
+	ValueChange  int64
+	Retired      bool
+	Regen        *Player // Youth successor, set when the player retired
+}
+
+// NewDevelopmentManagerWithSeed creates a development manager whose
+// outcomes are reproducible for the seed
+func NewDevelopmentManagerWithSeed(seed int64) *DevelopmentManager {
+	return NewDevelopmentManagerWithSource(common.NewRandSource(seed))
+}
+
+// AdvanceSeason ages a player by one season, applying natural development
+// or decline, revaluing them, and letting veterans consider retirement.
+// Retired players should be removed from their squad; a youth regen is
+// generated to replace them.
+func (dm *DevelopmentManager) AdvanceSeason(player *Player) SeasonAdvance {
+	if player.Status == StatusRetired {
+		return SeasonAdvance{Retired: true}
+	}
+
+	ratingBefore := player.GetOverallRating()
+	valueBefore := player.MarketValue
+	estimateBefore := estimateMarketValue(player)
+
+	player.DateOfBirth = player.DateOfBirth.AddDate(-1, 0, 0)
+	for i := 0; i < developmentTicksPerSeason; i++ {
+		dm.ProcessNaturalDevelopment(player)
+	}
+
+	// Move the value in line with the change in estimated worth
+	estimate := estimateMarketValue(player)
+	if player.MarketValue > 0 && estimateBefore > 0 {
+		player.MarketValue = int64(float64(player.MarketValue) * float64(estimate) / float64(estimateBefore))
+	} else {
+		player.MarketValue = estimate
+	}
+
+	advance := SeasonAdvance{
+		RatingChange: player.GetOverallRating() - ratingBefore,
+		ValueChange:  player.MarketValue - valueBefore,
+	}
+
+	advance.Retired = player.ConsiderRetirementWithSource(dm.rand)
+	if advance.Retired {
+		regen := GenerateRegenWithSource(player, dm.rand)
+		advance.Regen = &regen
+	}
+	player.UpdatedAt = time.Now()
+
+	return advance
+}
+
+// estimateMarketValue values a player from ability, age and potential
+func estimateMarketValue(player *Player) int64 {
+	rating := float64(player.GetOverallRating())
+	value := 10000 * math.Exp((rating-50)/6)
+
+	age := player.Age()
+	switch {
+	case age <= 23:
+		// Youngsters are valued on what they may become
+		gap := math.Max(0, float64(player.Attributes.Potential)-rating)
+		value *= 1.2 + gap/20
+	case age >= 30:
+		value *= math.Max(0.2, 0.8-0.1*float64(age-30))
+	}
+
+	return int64(math.Round(value/1000) * 1000)
+}
@@ -0,0 +1,109 @@
+// domain/player/aging_test.go
+package player
+
+import "testing"
+
+func TestAdvanceSeasonRollsOver(t *testing.T) {
+	tests := []struct {
+		name       string
+		age        int
+		skill      int
+		potential  int
+		value      int64
+		trait      string // Attribute whose change is judged, or the overall rating when empty
+		wantTrend  int    // Sign of the trait's total change over the seeds
+		wantValued bool   // A missing market value should be estimated
+	}{
+		{"prospect grows", 18, 55, 85, 500000, "", 1, false},
+		{"veteran slows down", 34, 75, 75, 2000000, "Speed", -1, false},
+		{"unvalued player in their prime holds steady", 25, 65, 70, 0, "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total := 0
+			for seed := int64(1); seed <= 20; seed++ {
+				p := newTestPlayer("p", PositionMID, tt.age)
+				for _, name := range AttributeNames() {
+					if err := p.Attributes.Set(name, tt.skill); err != nil {
+						t.Fatal(err)
+					}
+				}
+				p.Attributes.SetPotentialRange(tt.potential, tt.potential)
+				p.MarketValue = tt.value
+				ratingBefore := p.GetOverallRating()
+				traitBefore, _ := p.Attributes.Get(tt.trait)
+
+				advance := NewDevelopmentManagerWithSeed(seed).AdvanceSeason(p)
+				if advance.Retired {
+					continue
+				}
+
+				if got := p.Age(); got != tt.age+1 {
+					t.Fatalf("seed %d: age %d after a season, want %d", seed, got, tt.age+1)
+				}
+				if advance.RatingChange != p.GetOverallRating()-ratingBefore {
+					t.Errorf("seed %d: RatingChange = %d, rating went from %d to %d", seed, advance.RatingChange, ratingBefore, p.GetOverallRating())
+				}
+				if advance.ValueChange != p.MarketValue-tt.value {
+					t.Errorf("seed %d: ValueChange = %d, value went from %d to %d", seed, advance.ValueChange, tt.value, p.MarketValue)
+				}
+				if tt.wantValued && p.MarketValue <= 0 {
+					t.Errorf("seed %d: market value %d, want an estimate", seed, p.MarketValue)
+				}
+				if tt.trait == "" {
+					total += advance.RatingChange
+				} else {
+					after, _ := p.Attributes.Get(tt.trait)
+					total += after - traitBefore
+				}
+			}
+
+			if trend := sign(total); trend != tt.wantTrend {
+				t.Errorf("changed by %d in total over 20 seeds, want a trend of %d", total, tt.wantTrend)
+			}
+		})
+	}
+}
+
+func TestAdvanceSeasonRetirement(t *testing.T) {
+	retired := newTestPlayer("gone", PositionDEF, 38)
+	if err := retired.SetStatus(StatusRetired); err != nil {
+		t.Fatal(err)
+	}
+	dob := retired.DateOfBirth
+	if advance := NewDevelopmentManagerWithSeed(1).AdvanceSeason(retired); !advance.Retired || advance.Regen != nil {
+		t.Errorf("retired player's season = %+v, want retired with no new regen", advance)
+	}
+	if !retired.DateOfBirth.Equal(dob) {
+		t.Error("retired player aged another season")
+	}
+
+	// A 40-year-old almost always calls it a day
+	for seed := int64(1); seed <= 20; seed++ {
+		p := newTestPlayer("vet", PositionDEF, 40)
+		advance := NewDevelopmentManagerWithSeed(seed).AdvanceSeason(p)
+		if !advance.Retired {
+			continue
+		}
+		if p.Status != StatusRetired {
+			t.Errorf("status = %s after retiring, want retired", p.Status)
+		}
+		if advance.Regen == nil || advance.Regen.ID == p.ID {
+			t.Errorf("regen = %+v, want a new youth player", advance.Regen)
+		}
+		return
+	}
+	t.Error("no 40-year-old retired over 20 seeds")
+}
+
+// sign returns -1, 0 or 1 by the sign of n
+func sign(n int) int {
+	switch {
+	case n > 0:
+		return 1
+	case n < 0:
+		return -1
+	}
+	return 0
+}
@@ -0,0 +1,26 @@
+// domain/player/ambition.go
+package player
+
+const (
+	// ambitionGap is how far a player's ambition must exceed the club's
+	// before they push for a move
+	ambitionGap = 25
+	// starRating is the rating from which other clubs' interest emboldens
+	// a player to leave
+	starRating = 70
+)
+
+// WantsToLeave reports whether the player's ambition has outgrown a club
+// with the given ambition (0-100). Better players, who know they will
+// attract offers, are quicker to ask for a move.
+func (p *Player) WantsToLeave(teamAmbition int) bool {
+	if p.Status == StatusRetired || p.Status == StatusOnLoan {
+		return false
+	}
+
+	gap := p.Attributes.Ambition - teamAmbition
+	if rating := p.GetOverallRating(); rating > starRating {
+		gap += (rating - starRating) / 2
+	}
+	return gap > ambitionGap
+}
@@ -0,0 +1,40 @@
+// domain/player/ambition_test.go
+package player
+
+import "testing"
+
+func TestWantsToLeave(t *testing.T) {
+	tests := []struct {
+		name         string
+		ambition     int
+		rating       int
+		status       Status
+		teamAmbition int
+		want         bool
+	}{
+		{"content at an ambitious club", 80, 60, StatusAvailable, 80, false},
+		{"gap exactly at the limit", 80, 60, StatusAvailable, 55, false},
+		{"gap just over the limit", 80, 60, StatusAvailable, 54, true},
+		{"star is quicker to ask", 80, 90, StatusAvailable, 64, true},
+		{"same gap without star rating", 80, 70, StatusAvailable, 64, false},
+		{"on loan", 100, 90, StatusOnLoan, 0, false},
+		{"retired", 100, 90, StatusRetired, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, 27)
+			for _, name := range AttributeNames() {
+				if err := p.Attributes.Set(name, tt.rating); err != nil {
+					t.Fatal(err)
+				}
+			}
+			p.Attributes.Ambition = tt.ambition
+			p.Status = tt.status
+
+			if got := p.WantsToLeave(tt.teamAmbition); got != tt.want {
+				t.Errorf("WantsToLeave(%d) = %v, want %v", tt.teamAmbition, got, tt.want)
+			}
+		})
+	}
+}
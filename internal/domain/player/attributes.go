@@ -1,6 +1,13 @@
 // domain/player/attributes.go
 package player
 
+import (
+	"fmt"
+	"math"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
 // Attributes represents player attributes (0-100 scale)
 type Attributes struct {
 	// Overall
@@ -22,11 +29,14 @@ type Attributes struct {
 	BallControl int // Bc: Technical ability
 
 	// Hidden attributes (affect development and consistency)
-	Consistency      int // How consistent performances are
-	ImportantMatches int // Performance in big games
-	Potential        int // Maximum potential ability
-	Ambition         int // Drive to improve
-	Professionalism  int // Training attitude
+	Consistency      int    // How consistent performances are
+	ImportantMatches int    // Performance in big games
+	Potential        int    // Maximum potential ability (best estimate of PotentialRange)
+	PotentialRange   [2]int // True min/max ceiling, zero if unknown
+	PotentialCap     int    // Ceiling drawn from PotentialRange, zero until first needed
+	Ambition         int    // Drive to improve
+	Professionalism  int    // Training attitude
+	InjuryProneness  int    // Susceptibility to injury, zero if unknown
 }
 
 // NewDefaultAttributes creates default attributes based on position
@@ -38,6 +48,7 @@ func NewDefaultAttributes(position Position) Attributes {
 		Potential:        75,
 		Ambition:         70,
 		Professionalism:  70,
+		InjuryProneness:  averageInjuryProneness,
 	}
 
 	switch position {
@@ -86,50 +97,33 @@ func NewDefaultAttributes(position Position) Attributes {
 	return base
 }
 
+// GetInjuryProneness returns how susceptible the player is to injury
+// (1-100), treating an unknown value as average
+func (a *Attributes) GetInjuryProneness() int {
+	if a.InjuryProneness <= 0 {
+		return averageInjuryProneness
+	}
+	return a.InjuryProneness
+}
+
 // GetGoalkeeperRating calculates GK overall rating
 func (a *Attributes) GetGoalkeeperRating() int {
-	return int(
-		float64(a.Keeping)*0.5 +
-			float64(a.Speed)*0.1 +
-			float64(a.Perception)*0.2 +
-			float64(a.Stamina)*0.1 +
-			float64(a.Passing)*0.1,
-	)
+	return a.positionRating(PositionGK)
 }
 
 // GetDefenderRating calculates DEF overall rating
 func (a *Attributes) GetDefenderRating() int {
-	return int(
-		float64(a.Tackling)*0.3 +
-			float64(a.Heading)*0.2 +
-			float64(a.Speed)*0.15 +
-			float64(a.Stamina)*0.15 +
-			float64(a.Passing)*0.1 +
-			float64(a.Perception)*0.1,
-	)
+	return a.positionRating(PositionDEF)
 }
 
 // GetMidfielderRating calculates MID overall rating
 func (a *Attributes) GetMidfielderRating() int {
-	return int(
-		float64(a.Passing)*0.25 +
-			float64(a.BallControl)*0.2 +
-			float64(a.Perception)*0.15 +
-			float64(a.Stamina)*0.15 +
-			float64(a.Tackling)*0.15 +
-			float64(a.Shooting)*0.1,
-	)
+	return a.positionRating(PositionMID)
 }
 
 // GetForwardRating calculates FWD overall rating
 func (a *Attributes) GetForwardRating() int {
-	return int(
-		float64(a.Shooting)*0.3 +
-			float64(a.BallControl)*0.2 +
-			float64(a.Speed)*0.2 +
-			float64(a.Heading)*0.15 +
-			float64(a.Perception)*0.15,
-	)
+	return a.positionRating(PositionFWD)
 }
 
 // CanImprove checks if attribute can still improve
@@ -147,3 +141,196 @@ func (a *Attributes) CanImprove(attribute string, currentAge int) bool {
 		return currentAge < 30
 	}
 }
+
+// Get returns a skill attribute by name
+func (a *Attributes) Get(name string) (int, bool) {
+	switch name {
+	case "Keeping":
+		return a.Keeping, true
+	case "Tackling":
+		return a.Tackling, true
+	case "Passing":
+		return a.Passing, true
+	case "Shooting":
+		return a.Shooting, true
+	case "Heading":
+		return a.Heading, true
+	case "Speed":
+		return a.Speed, true
+	case "Stamina":
+		return a.Stamina, true
+	case "Perception":
+		return a.Perception, true
+	case "BallControl":
+		return a.BallControl, true
+	default:
+		return 0, false
+	}
+}
+
+// Set updates a skill attribute by name
+func (a *Attributes) Set(name string, v int) error {
+	if v < 0 || v > 100 {
+		return fmt.Errorf("attribute %s value %d out of range 0-100", name, v)
+	}
+
+	switch name {
+	case "Keeping":
+		a.Keeping = v
+	case "Tackling":
+		a.Tackling = v
+	case "Passing":
+		a.Passing = v
+	case "Shooting":
+		a.Shooting = v
+	case "Heading":
+		a.Heading = v
+	case "Speed":
+		a.Speed = v
+	case "Stamina":
+		a.Stamina = v
+	case "Perception":
+		a.Perception = v
+	case "BallControl":
+		a.BallControl = v
+	default:
+		return fmt.Errorf("unknown attribute %q", name)
+	}
+	return nil
+}
+
+// boundedAttribute names an attribute field for range checks
+type boundedAttribute struct {
+	name  string
+	value *int
+}
+
+// bounded lists every attribute held on the 0-100 scale, hidden ones
+// included
+func (a *Attributes) bounded() []boundedAttribute {
+	return []boundedAttribute{
+		{"Quality", &a.Quality},
+		{"Keeping", &a.Keeping},
+		{"Tackling", &a.Tackling},
+		{"Passing", &a.Passing},
+		{"Shooting", &a.Shooting},
+		{"Heading", &a.Heading},
+		{"Speed", &a.Speed},
+		{"Stamina", &a.Stamina},
+		{"Perception", &a.Perception},
+		{"BallControl", &a.BallControl},
+		{"Consistency", &a.Consistency},
+		{"ImportantMatches", &a.ImportantMatches},
+		{"Potential", &a.Potential},
+		{"PotentialMin", &a.PotentialRange[0]},
+		{"PotentialMax", &a.PotentialRange[1]},
+		{"PotentialCap", &a.PotentialCap},
+		{"Ambition", &a.Ambition},
+		{"Professionalism", &a.Professionalism},
+		{"InjuryProneness", &a.InjuryProneness},
+	}
+}
+
+// Validate reports every attribute outside the 0-100 scale and a potential
+// range whose maximum is below its minimum
+func (a *Attributes) Validate() error {
+	var errs common.ValidationErrors
+	for _, attr := range a.bounded() {
+		if *attr.value < 0 || *attr.value > 100 {
+			errs.Add(common.ErrInvalidAttribute.WithDetails(map[string]interface{}{
+				"attribute": attr.name,
+				"value":     *attr.value,
+			}))
+		}
+	}
+	if min, max := a.PotentialRange[0], a.PotentialRange[1]; max < min {
+		errs.Add(common.ErrInvalidAttribute.WithDetails(map[string]interface{}{
+			"attribute": "PotentialRange",
+			"min":       min,
+			"max":       max,
+		}))
+	}
+	return errs.ErrOrNil()
+}
+
+// ClampAll brings every attribute back within the 0-100 scale
+func (a *Attributes) ClampAll() {
+	for _, attr := range a.bounded() {
+		*attr.value = clampAttribute(*attr.value)
+	}
+}
+
+// skillAttributes lists the trainable attributes in display order
+var skillAttributes = []string{
+	"Keeping", "Tackling", "Passing", "Shooting", "Heading",
+	"Speed", "Stamina", "Perception", "BallControl",
+}
+
+// AttributeNames returns the canonical names of the skill attributes, the
+// names accepted by Get and Set
+func AttributeNames() []string {
+	return append([]string(nil), skillAttributes...)
+}
+
+// ForEach calls fn for every skill attribute in canonical order
+func (a *Attributes) ForEach(fn func(name string, value int)) {
+	for _, name := range skillAttributes {
+		v, _ := a.Get(name)
+		fn(name, v)
+	}
+}
+
+// Sum totals the skill attributes
+func (a *Attributes) Sum() int {
+	total := 0
+	a.ForEach(func(_ string, value int) {
+		total += value
+	})
+	return total
+}
+
+// Average returns the mean skill attribute
+func (a *Attributes) Average() float64 {
+	return float64(a.Sum()) / float64(len(skillAttributes))
+}
+
+// Diff returns how far each skill attribute is above (positive) or below
+// (negative) the other set
+func (a *Attributes) Diff(other Attributes) map[string]int {
+	diff := make(map[string]int, len(skillAttributes))
+	a.ForEach(func(name string, mine int) {
+		theirs, _ := other.Get(name)
+		diff[name] = mine - theirs
+	})
+	return diff
+}
+
+// ScaleToQuality scales the skill attributes proportionally so their
+// average matches the target, and sets Quality to the average reached,
+// which falls short of the target when attributes hit the 0-100 bounds.
+// The attributes are left unchanged if any fails to update.
+func (a *Attributes) ScaleToQuality(target int) error {
+	target = clampAttribute(target)
+
+	avg := a.Average()
+	if avg == 0 {
+		a.Quality = 0
+		return nil
+	}
+
+	factor := float64(target) / avg
+	scaled := *a
+	var err error
+	a.ForEach(func(name string, value int) {
+		if err == nil {
+			err = scaled.Set(name, clampAttribute(int(math.Round(float64(value)*factor))))
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	scaled.Quality = int(math.Round(scaled.Average()))
+	*a = scaled
+	return nil
+}
@@ -0,0 +1,197 @@
+// domain/player/attributes_test.go
+package player
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+func TestDefaultAttributesAreValid(t *testing.T) {
+	for _, pos := range []Position{PositionGK, PositionDEF, PositionMID, PositionFWD} {
+		attrs := NewDefaultAttributes(pos)
+		if err := attrs.Validate(); err != nil {
+			t.Errorf("NewDefaultAttributes(%s) invalid: %v", pos, err)
+		}
+	}
+}
+
+func TestNewPlayerWithAttributes(t *testing.T) {
+	dob := time.Now().AddDate(-22, 0, 0)
+
+	tests := []struct {
+		name    string
+		modify  func(a *Attributes)
+		wantErr bool
+	}{
+		{"defaults", func(*Attributes) {}, false},
+		{"bounds inclusive", func(a *Attributes) { a.Speed, a.Passing = 0, 100 }, false},
+		{"above scale", func(a *Attributes) { a.Shooting = 101 }, true},
+		{"below scale", func(a *Attributes) { a.Stamina = -1 }, true},
+		{"hidden attribute", func(a *Attributes) { a.Professionalism = 150 }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := NewDefaultAttributes(PositionMID)
+			tt.modify(&attrs)
+
+			p, err := NewPlayerWithAttributes("p", "Test", "Player", PositionMID, dob, attrs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, common.ErrInvalidAttribute) {
+					t.Errorf("error = %v, want ErrInvalidAttribute", err)
+				}
+				if p != nil {
+					t.Error("returned a player alongside the error")
+				}
+				return
+			}
+			if p.Attributes != attrs {
+				t.Errorf("Attributes = %+v, want %+v", p.Attributes, attrs)
+			}
+		})
+	}
+}
+
+func TestClampAll(t *testing.T) {
+	attrs := NewDefaultAttributes(PositionFWD)
+	attrs.Shooting, attrs.Speed, attrs.Ambition = 140, -20, 101
+
+	if err := attrs.Validate(); err == nil {
+		t.Fatal("Validate accepted out-of-range attributes")
+	}
+	attrs.ClampAll()
+	if err := attrs.Validate(); err != nil {
+		t.Fatalf("Validate after ClampAll: %v", err)
+	}
+	if attrs.Shooting != 100 || attrs.Speed != 0 || attrs.Ambition != 100 {
+		t.Errorf("clamped to %d, %d, %d; want 100, 0, 100", attrs.Shooting, attrs.Speed, attrs.Ambition)
+	}
+}
+
+func TestValidateRejectsInvertedPotentialRange(t *testing.T) {
+	attrs := NewDefaultAttributes(PositionMID)
+	attrs.PotentialRange = [2]int{85, 70}
+
+	if err := attrs.Validate(); err == nil {
+		t.Error("Validate accepted a potential range with max below min")
+	}
+	attrs.SetPotentialRange(85, 70)
+	if err := attrs.Validate(); err != nil {
+		t.Errorf("Validate after SetPotentialRange: %v", err)
+	}
+}
+
+func TestScaleToQuality(t *testing.T) {
+	// uniform sets every skill attribute to v, except Keeping
+	uniform := func(v, keeping int) Attributes {
+		var a Attributes
+		for _, name := range AttributeNames() {
+			_ = a.Set(name, v)
+		}
+		a.Keeping = keeping
+		return a
+	}
+
+	tests := []struct {
+		name        string
+		attrs       Attributes
+		target      int
+		wantQuality int
+	}{
+		{"scales down to the target", uniform(80, 80), 60, 60},
+		{"scales up to the target", uniform(50, 50), 75, 75},
+		{"target clamped to the scale", uniform(50, 50), 130, 100},
+		{"bounded attributes fall short", uniform(90, 10), 100, 90},
+		{"nothing to scale", uniform(0, 0), 70, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := tt.attrs
+			if err := attrs.ScaleToQuality(tt.target); err != nil {
+				t.Fatalf("ScaleToQuality: %v", err)
+			}
+			if attrs.Quality != tt.wantQuality {
+				t.Errorf("Quality = %d, want %d", attrs.Quality, tt.wantQuality)
+			}
+			if got := int(math.Round(attrs.Average())); got != attrs.Quality {
+				t.Errorf("average %d, want it to match Quality %d", got, attrs.Quality)
+			}
+			if err := attrs.Validate(); err != nil {
+				t.Errorf("Validate after scaling: %v", err)
+			}
+		})
+	}
+}
+
+func TestAttributeNamesRoundTrip(t *testing.T) {
+	names := AttributeNames()
+	if len(names) == 0 {
+		t.Fatal("AttributeNames() is empty")
+	}
+
+	var attrs Attributes
+	want := make(map[string]int, len(names))
+	for i, name := range names {
+		want[name] = 10 + i
+		if err := attrs.Set(name, want[name]); err != nil {
+			t.Fatalf("Set(%s): %v", name, err)
+		}
+	}
+
+	for _, name := range names {
+		if got, ok := attrs.Get(name); !ok || got != want[name] {
+			t.Errorf("Get(%s) = %d, %v; want %d", name, got, ok, want[name])
+		}
+	}
+
+	visited := []string{}
+	attrs.ForEach(func(name string, value int) {
+		visited = append(visited, name)
+		if value != want[name] {
+			t.Errorf("ForEach gave %s = %d, want %d", name, value, want[name])
+		}
+	})
+	if !reflect.DeepEqual(visited, names) {
+		t.Errorf("ForEach visited %v, want %v", visited, names)
+	}
+
+	names[0] = "Changed"
+	if got := AttributeNames()[0]; got == "Changed" {
+		t.Error("AttributeNames() shares its slice with callers")
+	}
+}
+
+func TestAttributeSetRejects(t *testing.T) {
+	tests := []struct {
+		name  string
+		attr  string
+		value int
+	}{
+		{"unknown name", "Dribbling", 50},
+		{"hidden attribute", "Professionalism", 50},
+		{"above scale", "Passing", 101},
+		{"below scale", "Passing", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := NewDefaultAttributes(PositionMID)
+			before := attrs
+			if err := attrs.Set(tt.attr, tt.value); err == nil {
+				t.Errorf("Set(%s, %d) succeeded", tt.attr, tt.value)
+			}
+			if attrs != before {
+				t.Errorf("Set(%s, %d) changed the attributes", tt.attr, tt.value)
+			}
+		})
+	}
+}
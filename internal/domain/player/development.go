@@ -2,20 +2,28 @@
 package player
 
 import (
+	"fmt"
 	"math"
-	"math/rand"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
 )
 
-// DevelopmentManager handles player growth and decline
+// DevelopmentManager handles player growth and decline. It is safe for
+// concurrent use across different players as long as its random source is;
+// a single player must not be developed from several goroutines at once.
 type DevelopmentManager struct {
-	rand *rand.Rand
+	rand common.RandSource
 }
 
 // NewDevelopmentManager creates a development manager
 func NewDevelopmentManager() *DevelopmentManager {
-	return &DevelopmentManager{
-		rand: rand.New(rand.NewSource(42)), // Use seeded random for consistency
-	}
+	return NewDevelopmentManagerWithSource(common.NewRandSource(42)) // Use seeded random for consistency
+}
+
+// NewDevelopmentManagerWithSource creates a development manager that draws
+// its randomness from src
+func NewDevelopmentManagerWithSource(src common.RandSource) *DevelopmentManager {
+	return &DevelopmentManager{rand: src}
 }
 
 // TrainingType represents different training focuses
@@ -34,6 +42,8 @@ type TrainingResult struct {
 	AttributeChanges map[string]int
 	FitnessChange    float64
 	MoraleChange     float64
+	Overtrained      bool               // Returns diminished by repeating the same session
+	Plateaued        []AttributePlateau // Trained attributes at or near their limit
 }
 
 // ProcessTraining applies training effects to a player
@@ -42,8 +52,10 @@ func (dm *DevelopmentManager) ProcessTraining(player *Player, trainingType Train
 		AttributeChanges: make(map[string]int),
 	}
 
-	// Base improvement chance
-	improvementChance := dm.calculateImprovementChance(player)
+	// Base improvement chance, diminished by repeating the same session
+	sessions := player.TrainingLoad.record(trainingType)
+	improvementChance := dm.calculateImprovementChance(player) * overtrainingFactor(sessions)
+	result.Overtrained = player.TrainingLoad.Overtrained()
 
 	// Apply training based on type
 	switch trainingType {
@@ -58,6 +70,7 @@ func (dm *DevelopmentManager) ProcessTraining(player *Player, trainingType Train
 	default:
 		dm.trainGeneral(player, improvementChance, &result)
 	}
+	result.Plateaued = dm.findPlateaus(player, trainingFocus(player, trainingType))
 
 	// Fitness impact
 	result.FitnessChange = -5 * intensity
@@ -68,6 +81,7 @@ func (dm *DevelopmentManager) ProcessTraining(player *Player, trainingType Train
 	} else if intensity > 0.9 {
 		result.MoraleChange = -3
 	}
+	result.MoraleChange -= overtrainingMoraleLoss(sessions)
 
 	return result
 }
@@ -76,9 +90,11 @@ func (dm *DevelopmentManager) ProcessTraining(player *Player, trainingType Train
 func (dm *DevelopmentManager) ProcessNaturalDevelopment(player *Player) {
 	age := player.Age()
 
-	// Young players improve naturally
+	// Young players improve naturally until they reach their ceiling
 	if age < 23 {
-		dm.youngPlayerDevelopment(player)
+		if player.GetOverallRating() < samplePotential(dm.rand, &player.Attributes) {
+			dm.youngPlayerDevelopment(player)
+		}
 	} else if age > 30 {
 		dm.veteranDecline(player)
 	}
@@ -122,14 +138,13 @@ func (dm *DevelopmentManager) calculateImprovementChance(player *Player) float64
 
 // trainTechnical focuses on technical skills
 func (dm *DevelopmentManager) trainTechnical(player *Player, chance float64, result *TrainingResult) {
-	attrs := []string{"Passing", "BallControl", "Shooting"}
+	attrs := trainingFocus(player, TrainingTechnical)
 
 	for _, attr := range attrs {
 		if dm.rand.Float64() < chance {
 			improvement := dm.calculateImprovement(player, attr)
-			if improvement > 0 {
+			if improvement > 0 && dm.applyAttributeChange(player, attr, improvement) == nil {
 				result.AttributeChanges[attr] = improvement
-				dm.applyAttributeChange(player, attr, improvement)
 			}
 		}
 	}
@@ -137,14 +152,13 @@ func (dm *DevelopmentManager) trainTechnical(player *Player, chance float64, res
 
 // trainPhysical focuses on physical attributes
 func (dm *DevelopmentManager) trainPhysical(player *Player, chance float64, result *TrainingResult) {
-	attrs := []string{"Speed", "Stamina", "Heading"}
+	attrs := trainingFocus(player, TrainingPhysical)
 
 	for _, attr := range attrs {
 		if dm.rand.Float64() < chance*0.8 { // Harder to improve physical
 			improvement := dm.calculateImprovement(player, attr)
-			if improvement > 0 {
+			if improvement > 0 && dm.applyAttributeChange(player, attr, improvement) == nil {
 				result.AttributeChanges[attr] = improvement
-				dm.applyAttributeChange(player, attr, improvement)
 			}
 		}
 	}
@@ -152,14 +166,13 @@ func (dm *DevelopmentManager) trainPhysical(player *Player, chance float64, resu
 
 // trainTactical focuses on mental attributes
 func (dm *DevelopmentManager) trainTactical(player *Player, chance float64, result *TrainingResult) {
-	attrs := []string{"Perception", "Tackling"}
+	attrs := trainingFocus(player, TrainingTactical)
 
 	for _, attr := range attrs {
 		if dm.rand.Float64() < chance {
 			improvement := dm.calculateImprovement(player, attr)
-			if improvement > 0 {
+			if improvement > 0 && dm.applyAttributeChange(player, attr, improvement) == nil {
 				result.AttributeChanges[attr] = improvement
-				dm.applyAttributeChange(player, attr, improvement)
 			}
 		}
 	}
@@ -171,9 +184,8 @@ func (dm *DevelopmentManager) trainSetPieces(player *Player, chance float64, res
 		// Goalkeepers improve keeping
 		if dm.rand.Float64() < chance {
 			improvement := dm.calculateImprovement(player, "Keeping")
-			if improvement > 0 {
+			if improvement > 0 && dm.applyAttributeChange(player, "Keeping", improvement) == nil {
 				result.AttributeChanges["Keeping"] = improvement
-				player.Attributes.Keeping += improvement
 			}
 		}
 	} else {
@@ -182,9 +194,8 @@ func (dm *DevelopmentManager) trainSetPieces(player *Player, chance float64, res
 		for _, attr := range attrs {
 			if dm.rand.Float64() < chance*0.7 {
 				improvement := dm.calculateImprovement(player, attr)
-				if improvement > 0 {
+				if improvement > 0 && dm.applyAttributeChange(player, attr, improvement) == nil {
 					result.AttributeChanges[attr] = improvement
-					dm.applyAttributeChange(player, attr, improvement)
 				}
 			}
 		}
@@ -194,8 +205,7 @@ func (dm *DevelopmentManager) trainSetPieces(player *Player, chance float64, res
 // trainGeneral provides balanced training
 func (dm *DevelopmentManager) trainGeneral(player *Player, chance float64, result *TrainingResult) {
 	// Small chance to improve any attribute
-	allAttrs := []string{"Keeping", "Tackling", "Passing", "Shooting", "Heading",
-		"Speed", "Stamina", "Perception", "BallControl"}
+	allAttrs := AttributeNames()
 
 	// Pick 2-3 random attributes
 	numAttrs := 2 + dm.rand.Intn(2)
@@ -203,26 +213,27 @@ func (dm *DevelopmentManager) trainGeneral(player *Player, chance float64, resul
 		attr := allAttrs[dm.rand.Intn(len(allAttrs))]
 		if dm.rand.Float64() < chance*0.5 {
 			improvement := dm.calculateImprovement(player, attr)
-			if improvement > 0 {
+			if improvement > 0 && dm.applyAttributeChange(player, attr, improvement) == nil {
 				result.AttributeChanges[attr] = improvement
-				dm.applyAttributeChange(player, attr, improvement)
 			}
 		}
 	}
 }
 
-// calculateImprovement determines attribute improvement amount
+// calculateImprovement determines attribute improvement amount. Progress
+// slows as an attribute nears the player's potential and stops at it.
 func (dm *DevelopmentManager) calculateImprovement(player *Player, attribute string) int {
 	current := dm.getAttributeValue(player, attribute)
+	headroom := dm.improvementCeiling(player) - current
 
-	// Harder to improve higher attributes
-	if current >= 95 {
+	// Harder to improve the closer an attribute is to the ceiling
+	if headroom <= 0 {
 		return 0
-	} else if current >= 90 {
+	} else if headroom <= nearCeilingMargin {
 		if dm.rand.Float64() < 0.1 {
 			return 1
 		}
-	} else if current >= 80 {
+	} else if headroom <= 15 {
 		if dm.rand.Float64() < 0.3 {
 			return 1
 		}
@@ -234,13 +245,21 @@ func (dm *DevelopmentManager) calculateImprovement(player *Player, attribute str
 	return 0
 }
 
+// improvementCeiling is the highest value training can take an attribute
+// to: the same sampled ceiling natural development stops at
+func (dm *DevelopmentManager) improvementCeiling(player *Player) int {
+	return clampAttribute(samplePotential(dm.rand, &player.Attributes))
+}
+
 // youngPlayerDevelopment handles natural growth for young players
 func (dm *DevelopmentManager) youngPlayerDevelopment(player *Player) {
+	ceiling := dm.improvementCeiling(player)
+
 	// Physical growth
 	if player.Age() < 21 {
 		if dm.rand.Float64() < 0.3 {
-			player.Attributes.Speed = int(math.Min(float64(player.Attributes.Speed+1), 100))
-			player.Attributes.Stamina = int(math.Min(float64(player.Attributes.Stamina+1), 100))
+			dm.growTowards(player, "Speed", ceiling)
+			dm.growTowards(player, "Stamina", ceiling)
 		}
 	}
 
@@ -249,7 +268,15 @@ func (dm *DevelopmentManager) youngPlayerDevelopment(player *Player) {
 		// Random attribute improvement
 		attrs := []string{"Passing", "BallControl", "Perception", "Tackling"}
 		attr := attrs[dm.rand.Intn(len(attrs))]
-		dm.applyAttributeChange(player, attr, 1)
+		dm.growTowards(player, attr, ceiling)
+	}
+}
+
+// growTowards raises an attribute by one unless it has reached the ceiling
+func (dm *DevelopmentManager) growTowards(player *Player, attribute string, ceiling int) {
+	if dm.getAttributeValue(player, attribute) < ceiling {
+		// Growth attributes always exist, so the change cannot fail
+		_ = dm.applyAttributeChange(player, attribute, 1)
 	}
 }
 
@@ -275,49 +302,22 @@ func (dm *DevelopmentManager) veteranDecline(player *Player) {
 // Helper methods
 
 func (dm *DevelopmentManager) getAttributeValue(player *Player, attribute string) int {
-	switch attribute {
-	case "Keeping":
-		return player.Attributes.Keeping
-	case "Tackling":
-		return player.Attributes.Tackling
-	case "Passing":
-		return player.Attributes.Passing
-	case "Shooting":
-		return player.Attributes.Shooting
-	case "Heading":
-		return player.Attributes.Heading
-	case "Speed":
-		return player.Attributes.Speed
-	case "Stamina":
-		return player.Attributes.Stamina
-	case "Perception":
-		return player.Attributes.Perception
-	case "BallControl":
-		return player.Attributes.BallControl
-	default:
-		return 50
+	if v, ok := player.Attributes.Get(attribute); ok {
+		return v
 	}
+	return 50
 }
 
-func (dm *DevelopmentManager) applyAttributeChange(player *Player, attribute string, change int) {
-	switch attribute {
-	case "Keeping":
-		player.Attributes.Keeping = int(math.Min(math.Max(float64(player.Attributes.Keeping+change), 0), 100))
-	case "Tackling":
-		player.Attributes.Tackling = int(math.Min(math.Max(float64(player.Attributes.Tackling+change), 0), 100))
-	case "Passing":
-		player.Attributes.Passing = int(math.Min(math.Max(float64(player.Attributes.Passing+change), 0), 100))
-	case "Shooting":
-		player.Attributes.Shooting = int(math.Min(math.Max(float64(player.Attributes.Shooting+change), 0), 100))
-	case "Heading":
-		player.Attributes.Heading = int(math.Min(math.Max(float64(player.Attributes.Heading+change), 0), 100))
-	case "Speed":
-		player.Attributes.Speed = int(math.Min(math.Max(float64(player.Attributes.Speed+change), 0), 100))
-	case "Stamina":
-		player.Attributes.Stamina = int(math.Min(math.Max(float64(player.Attributes.Stamina+change), 0), 100))
-	case "Perception":
-		player.Attributes.Perception = int(math.Min(math.Max(float64(player.Attributes.Perception+change), 0), 100))
-	case "BallControl":
-		player.Attributes.BallControl = int(math.Min(math.Max(float64(player.Attributes.BallControl+change), 0), 100))
+// applyAttributeChange moves an attribute by change within the 0-100 scale
+// and refreshes the cached rating
+func (dm *DevelopmentManager) applyAttributeChange(player *Player, attribute string, change int) error {
+	v, ok := player.Attributes.Get(attribute)
+	if !ok {
+		return fmt.Errorf("unknown attribute %q", attribute)
+	}
+	if err := player.Attributes.Set(attribute, clampAttribute(v+change)); err != nil {
+		return err
 	}
+	player.refreshRating()
+	return nil
 }
@@ -0,0 +1,277 @@
+// domain/player/development_test.go
+package player
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+func TestDevelopmentManagerConcurrentTraining(t *testing.T) {
+	dm := NewDevelopmentManagerWithSource(common.NewRandSource(3))
+	trainingTypes := []TrainingType{TrainingGeneral, TrainingTechnical, TrainingPhysical, TrainingTactical, TrainingSetPieces}
+
+	const workers = 8
+	players := make([]*Player, workers)
+	for i := range players {
+		players[i] = newTestPlayer(fmt.Sprintf("p%d", i), PositionMID, 19+i)
+	}
+
+	var wg sync.WaitGroup
+	for _, p := range players {
+		wg.Add(1)
+		go func(p *Player) {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				dm.ProcessTraining(p, trainingTypes[i%len(trainingTypes)], 0.8)
+				if i%10 == 0 {
+					dm.ProcessNaturalDevelopment(p)
+				}
+			}
+		}(p)
+	}
+	wg.Wait()
+
+	for _, p := range players {
+		if err := p.Attributes.Validate(); err != nil {
+			t.Errorf("%s attributes after training: %v", p.ID, err)
+		}
+		if got, want := p.GetOverallRating(), roundRating(p.Attributes.positionScore(p.Position)); got != want {
+			t.Errorf("%s cached rating = %d, want %d", p.ID, got, want)
+		}
+	}
+}
+
+func TestDevelopmentManagerDeterministicForSeed(t *testing.T) {
+	train := func() []map[string]int {
+		dm := NewDevelopmentManagerWithSource(common.NewRandSource(11))
+		p := newTestPlayer("p", PositionFWD, 19)
+		changes := []map[string]int{}
+		for i := 0; i < 20; i++ {
+			changes = append(changes, dm.ProcessTraining(p, TrainingTechnical, 0.5).AttributeChanges)
+		}
+		return changes
+	}
+
+	if first, second := train(), train(); !reflect.DeepEqual(first, second) {
+		t.Errorf("same seed trained differently:\n%v\n%v", first, second)
+	}
+}
+
+func BenchmarkDevelopmentManagerParallelTraining(b *testing.B) {
+	dm := NewDevelopmentManagerWithSource(common.NewRandSource(5))
+	b.RunParallel(func(pb *testing.PB) {
+		p := newTestPlayer("p", PositionMID, 20)
+		for pb.Next() {
+			dm.ProcessTraining(p, TrainingGeneral, 0.5)
+		}
+	})
+}
+
+func TestNaturalDevelopmentSamplesPotentialOnce(t *testing.T) {
+	dm := NewDevelopmentManagerWithSource(common.NewRandSource(7))
+	p := newTestPlayer("p", PositionMID, 18)
+	p.Attributes.SetPotentialRange(60, 95)
+
+	dm.ProcessNaturalDevelopment(p)
+	ceiling := p.Attributes.PotentialCap
+	if ceiling < 60 || ceiling > 95 {
+		t.Fatalf("PotentialCap = %d, want within 60-95", ceiling)
+	}
+	for i := 0; i < 200; i++ {
+		dm.ProcessNaturalDevelopment(p)
+		if p.Attributes.PotentialCap != ceiling {
+			t.Fatalf("PotentialCap changed from %d to %d on call %d", ceiling, p.Attributes.PotentialCap, i+2)
+		}
+	}
+
+	p.Attributes.SetPotentialRange(40, 45)
+	dm.ProcessNaturalDevelopment(p)
+	if got := p.Attributes.PotentialCap; got < 40 || got > 45 {
+		t.Errorf("PotentialCap = %d after narrowing the range, want within 40-45", got)
+	}
+}
+
+func TestCalculateImprovementStopsAtPotential(t *testing.T) {
+	tests := []struct {
+		name      string
+		potential int
+		current   int
+		wantMax   int
+	}{
+		{"at potential", 82, 82, 0},
+		{"above potential", 82, 88, 0},
+		{"just below potential", 82, 80, 1},
+		{"well below potential", 82, 60, 2},
+		{"high potential above 90", 99, 92, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm := NewDevelopmentManagerWithSource(common.NewRandSource(1))
+			p := newTestPlayer("p", PositionMID, 20)
+			p.Attributes.Potential = tt.potential
+			p.Attributes.Passing = tt.current
+
+			most := 0
+			for i := 0; i < 500; i++ {
+				if got := dm.calculateImprovement(p, "Passing"); got > most {
+					most = got
+				}
+			}
+			if most != tt.wantMax {
+				t.Errorf("largest improvement = %d, want %d", most, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestTrainingSharesSampledCeiling(t *testing.T) {
+	dm := NewDevelopmentManagerWithSource(common.NewRandSource(4))
+	p := newTestPlayer("p", PositionMID, 19)
+	p.Attributes.SetPotentialRange(65, 95)
+
+	dm.ProcessNaturalDevelopment(p)
+	ceiling := p.Attributes.PotentialCap
+	if got := dm.improvementCeiling(p); got != ceiling {
+		t.Fatalf("improvementCeiling() = %d, want the sampled ceiling %d", got, ceiling)
+	}
+
+	p.Attributes.Passing = ceiling
+	for i := 0; i < 500; i++ {
+		if got := dm.calculateImprovement(p, "Passing"); got != 0 {
+			t.Fatalf("improved Passing by %d at the sampled ceiling %d", got, ceiling)
+		}
+	}
+	if p.Attributes.PotentialCap != ceiling {
+		t.Errorf("PotentialCap changed from %d to %d during training", ceiling, p.Attributes.PotentialCap)
+	}
+}
+
+func TestTrainingPlateausAtPotential(t *testing.T) {
+	tests := []struct {
+		name      string
+		potential int
+		wantAbove int // Passing should pass this, or -1 to forbid passing the potential
+	}{
+		{"low potential plateaus", 70, -1},
+		{"high potential keeps climbing", 97, 85},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm := NewDevelopmentManagerWithSource(common.NewRandSource(2))
+			p := newTestPlayer("p", PositionMID, 18)
+			p.Attributes.Potential = tt.potential
+			p.Attributes.Professionalism = 100
+			p.Attributes.Passing = 60
+
+			for i := 0; i < 400; i++ {
+				p.TrainingLoad.Reset() // Keep overtraining out of the picture
+				dm.ProcessTraining(p, TrainingTechnical, 0.5)
+			}
+
+			if tt.wantAbove < 0 {
+				if p.Attributes.Passing > tt.potential {
+					t.Errorf("Passing = %d, want at most potential %d", p.Attributes.Passing, tt.potential)
+				}
+				if p.Attributes.Passing < tt.potential-nearCeilingMargin {
+					t.Errorf("Passing = %d, want it to reach the slow band below %d", p.Attributes.Passing, tt.potential)
+				}
+				return
+			}
+			if p.Attributes.Passing <= tt.wantAbove {
+				t.Errorf("Passing = %d, want above %d", p.Attributes.Passing, tt.wantAbove)
+			}
+		})
+	}
+}
+
+func TestApplyAttributeChange(t *testing.T) {
+	tests := []struct {
+		name      string
+		attribute string
+		start     int
+		change    int
+		want      int
+		wantErr   bool
+	}{
+		{"raises", "Passing", 60, 3, 63, false},
+		{"clamps at the top", "Passing", 98, 5, 100, false},
+		{"clamps at the bottom", "Passing", 2, -5, 0, false},
+		{"unknown attribute", "Flair", 60, 3, 60, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm := NewDevelopmentManagerWithSource(common.NewRandSource(1))
+			p := newTestPlayer("p", PositionMID, 22)
+			p.Attributes.Passing = tt.start
+
+			err := dm.applyAttributeChange(p, tt.attribute, tt.change)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("applyAttributeChange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if p.Attributes.Passing != tt.want {
+				t.Errorf("Passing = %d, want %d", p.Attributes.Passing, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetPieceTrainingImprovesKeeping(t *testing.T) {
+	dm := NewDevelopmentManagerWithSource(common.NewRandSource(3))
+	p := newTestPlayer("gk", PositionGK, 20)
+	p.Attributes.Potential = 80
+	p.Attributes.Professionalism = 100
+	p.Attributes.Keeping = 60
+
+	for i := 0; i < 300; i++ {
+		p.TrainingLoad.Reset()
+		before := p.Attributes.Keeping
+		result := dm.ProcessTraining(p, TrainingSetPieces, 0.5)
+		if got := p.Attributes.Keeping - before; got != result.AttributeChanges["Keeping"] {
+			t.Fatalf("session %d: Keeping moved %d, result records %d", i, got, result.AttributeChanges["Keeping"])
+		}
+	}
+
+	if p.Attributes.Keeping <= 60 || p.Attributes.Keeping > p.Attributes.Potential {
+		t.Errorf("Keeping = %d, want it raised but no higher than potential %d", p.Attributes.Keeping, p.Attributes.Potential)
+	}
+	if want := roundRating(p.Attributes.positionScore(PositionGK)); p.GetOverallRating() != want {
+		t.Errorf("GetOverallRating() = %d, want %d", p.GetOverallRating(), want)
+	}
+}
+
+func TestNaturalDevelopmentStopsAtSampledCeiling(t *testing.T) {
+	dm := NewDevelopmentManagerWithSource(common.NewRandSource(5))
+	p := newTestPlayer("p", PositionMID, 18)
+	p.Attributes.SetPotentialRange(75, 75)
+	p.Attributes.Speed = 75
+	p.Attributes.Stamina = 75
+	for _, attr := range []string{"Passing", "BallControl", "Perception", "Tackling"} {
+		if err := p.Attributes.Set(attr, 74); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if p.GetOverallRating() >= 75 {
+		t.Fatalf("rating %d leaves no room to develop", p.GetOverallRating())
+	}
+
+	for i := 0; i < 300; i++ {
+		dm.ProcessNaturalDevelopment(p)
+	}
+
+	for _, attr := range []string{"Speed", "Stamina", "Passing", "BallControl", "Perception", "Tackling"} {
+		v, _ := p.Attributes.Get(attr)
+		if v > 75 {
+			t.Errorf("%s = %d, want at most the ceiling 75", attr, v)
+		}
+	}
+	if p.Attributes.Passing != 75 {
+		t.Errorf("Passing = %d, want it grown to the ceiling 75", p.Attributes.Passing)
+	}
+}
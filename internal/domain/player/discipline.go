@@ -0,0 +1,105 @@
+// domain/player/discipline.go
+package player
+
+// SuspensionReason explains why a player was banned
+type SuspensionReason string
+
+const (
+	SuspensionRedCard            SuspensionReason = "red_card"
+	SuspensionSecondYellow       SuspensionReason = "second_yellow"
+	SuspensionYellowAccumulation SuspensionReason = "yellow_accumulation"
+)
+
+// SuspensionRules configures when bookings lead to bans
+type SuspensionRules struct {
+	YellowCardThreshold  int // Every Nth yellow in a season triggers a ban
+	YellowBanGames       int
+	RedCardBanGames      int // For a straight red
+	SecondYellowBanGames int // For a red shown for a second booking
+}
+
+// MatchCards counts a player's bookings in one match. A player sent off for
+// a second yellow has both yellows counted in Yellow and the red in Red as
+// well as in SecondYellow.
+type MatchCards struct {
+	Yellow       int
+	Red          int
+	SecondYellow int
+}
+
+// Suspension describes a ban triggered by a match
+type Suspension struct {
+	Games   int
+	Reasons []SuspensionReason
+}
+
+// DefaultSuspensionRules bans for one match every 5 yellows or for a second
+// yellow, and three for a straight red
+func DefaultSuspensionRules() SuspensionRules {
+	return SuspensionRules{
+		YellowCardThreshold:  5,
+		YellowBanGames:       1,
+		RedCardBanGames:      3,
+		SecondYellowBanGames: 1,
+	}
+}
+
+// accumulatedYellows counts the season's yellows toward the accumulation
+// threshold. The two yellows behind a second-yellow dismissal are punished
+// by that ban instead.
+func accumulatedYellows(season SeasonStats) int {
+	return season.YellowCards - 2*season.SecondYellows
+}
+
+// applySuspension bans the player if the match pushed them over a
+// threshold. An injured player's ban begins once they recover.
+func (p *Player) applySuspension(before SeasonStats, cards MatchCards, rules SuspensionRules) (*Suspension, error) {
+	suspension := &Suspension{}
+
+	if straightReds := cards.Red - cards.SecondYellow; straightReds > 0 && rules.RedCardBanGames > 0 {
+		suspension.Games += straightReds * rules.RedCardBanGames
+		suspension.Reasons = append(suspension.Reasons, SuspensionRedCard)
+	}
+
+	if cards.SecondYellow > 0 && rules.SecondYellowBanGames > 0 {
+		suspension.Games += cards.SecondYellow * rules.SecondYellowBanGames
+		suspension.Reasons = append(suspension.Reasons, SuspensionSecondYellow)
+	}
+
+	if rules.YellowCardThreshold > 0 {
+		yellowsBefore := accumulatedYellows(before)
+		yellowsAfter := accumulatedYellows(p.CareerStats.CurrentSeason)
+		crossed := yellowsAfter/rules.YellowCardThreshold - yellowsBefore/rules.YellowCardThreshold
+		if crossed > 0 && rules.YellowBanGames > 0 {
+			suspension.Games += crossed * rules.YellowBanGames
+			suspension.Reasons = append(suspension.Reasons, SuspensionYellowAccumulation)
+		}
+	}
+
+	if suspension.Games == 0 {
+		return nil, nil
+	}
+
+	p.SuspensionGames += suspension.Games
+	if p.Status == StatusAvailable {
+		if err := p.SetStatus(StatusSuspended); err != nil {
+			return suspension, err
+		}
+	}
+
+	return suspension, nil
+}
+
+// ServeSuspensionMatch counts a missed match against the ban and makes the
+// player available again once it is served
+func (p *Player) ServeSuspensionMatch() error {
+	if p.SuspensionGames <= 0 {
+		return nil
+	}
+
+	p.SuspensionGames--
+	if p.SuspensionGames == 0 && p.Status == StatusSuspended {
+		return p.SetStatus(StatusAvailable)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// domain/player/discipline_test.go
+package player
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestApplySuspension(t *testing.T) {
+	tests := []struct {
+		name          string
+		seasonYellows int // Accumulating yellows before the match
+		cards         MatchCards
+		wantGames     int
+		wantReasons   []SuspensionReason
+	}{
+		{"no cards", 0, MatchCards{}, 0, nil},
+		{"single yellow", 0, MatchCards{Yellow: 1}, 0, nil},
+		{"straight red", 0, MatchCards{Red: 1}, 3, []SuspensionReason{SuspensionRedCard}},
+		{"second yellow", 0, MatchCards{Yellow: 2, Red: 1, SecondYellow: 1}, 1, []SuspensionReason{SuspensionSecondYellow}},
+		{"fifth yellow", 4, MatchCards{Yellow: 1}, 1, []SuspensionReason{SuspensionYellowAccumulation}},
+		// The dismissal pair does not count toward accumulation, so a
+		// player on four yellows is not also banned for reaching five
+		{"second yellow on four", 4, MatchCards{Yellow: 2, Red: 1, SecondYellow: 1}, 1, []SuspensionReason{SuspensionSecondYellow}},
+		{"yellow then straight red on four", 4, MatchCards{Yellow: 1, Red: 1}, 4, []SuspensionReason{SuspensionRedCard, SuspensionYellowAccumulation}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionDEF, 26)
+			p.CareerStats.CurrentSeason.YellowCards = tt.seasonYellows
+
+			update, err := p.UpdateMatchStatsWithGoals(nil, 0, tt.cards, 6)
+			if err != nil {
+				t.Fatalf("UpdateMatchStatsWithGoals: %v", err)
+			}
+
+			if tt.wantGames == 0 {
+				if update.Suspension != nil {
+					t.Fatalf("Suspension = %+v, want none", update.Suspension)
+				}
+				if p.Status != StatusAvailable {
+					t.Errorf("Status = %s, want available", p.Status)
+				}
+				return
+			}
+			if update.Suspension == nil {
+				t.Fatal("no suspension triggered")
+			}
+			if update.Suspension.Games != tt.wantGames {
+				t.Errorf("Games = %d, want %d", update.Suspension.Games, tt.wantGames)
+			}
+			if !reflect.DeepEqual(update.Suspension.Reasons, tt.wantReasons) {
+				t.Errorf("Reasons = %v, want %v", update.Suspension.Reasons, tt.wantReasons)
+			}
+			if p.SuspensionGames != tt.wantGames || p.Status != StatusSuspended {
+				t.Errorf("player has %d games and status %s, want %d and suspended",
+					p.SuspensionGames, p.Status, tt.wantGames)
+			}
+		})
+	}
+}
+
+func TestSecondYellowsStayOutOfAccumulation(t *testing.T) {
+	p := newTestPlayer("p", PositionMID, 26)
+
+	// Sent off for two yellows, then three single bookings: five yellows in
+	// the season but only three accumulating, so no accumulation ban
+	if _, err := p.UpdateMatchStatsWithGoals(nil, 0, MatchCards{Yellow: 2, Red: 1, SecondYellow: 1}, 5); err != nil {
+		t.Fatal(err)
+	}
+	for p.SuspensionGames > 0 {
+		if err := p.ServeSuspensionMatch(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		update, err := p.UpdateMatchStats(0, 0, 1, 0, 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if update.Suspension != nil {
+			t.Fatalf("match %d: unexpected suspension %+v", i+1, update.Suspension)
+		}
+	}
+
+	season := p.CareerStats.CurrentSeason
+	if season.YellowCards != 5 || season.SecondYellows != 1 || season.RedCards != 1 {
+		t.Errorf("season cards = %d yellow, %d red, %d second yellow; want 5, 1, 1",
+			season.YellowCards, season.RedCards, season.SecondYellows)
+	}
+}
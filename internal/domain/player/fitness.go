@@ -3,6 +3,16 @@ package player
 
 import (
 	"math"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+const (
+	// maxMatchFatigue caps the fitness a player can lose in one match
+	maxMatchFatigue = 60.0
+	// extraTimeFatigue makes each minute of extra time more draining than
+	// one in regulation
+	extraTimeFatigue = 1.5
 )
 
 // FitnessManager handles player fitness calculations
@@ -10,18 +20,33 @@ type FitnessManager struct {
 	fatigueRate     float64
 	recoveryRate    float64
 	injuryThreshold float64
+	rand            common.RandSource
 }
 
 // NewFitnessManager creates a fitness manager
 func NewFitnessManager() *FitnessManager {
+	return NewFitnessManagerWithSource(common.NewRandSource(42))
+}
+
+// NewFitnessManagerWithSource creates a fitness manager that draws its
+// randomness from src
+func NewFitnessManagerWithSource(src common.RandSource) *FitnessManager {
 	return &FitnessManager{
 		fatigueRate:     0.15, // Base fatigue per minute played
 		recoveryRate:    10.0, // Base recovery per day
 		injuryThreshold: 40.0, // Below this fitness, injury risk increases
+		rand:            src,
 	}
 }
 
-// CalculateMatchFatigue calculates fitness loss from a match
+// RollInjury picks an injury for a player from the manager's source
+func (fm *FitnessManager) RollInjury(player *Player) Injury {
+	return RollInjuryWithSource(player, fm.rand)
+}
+
+// CalculateMatchFatigue calculates fitness loss from a match. The
+// intensity covers everything that makes the match more demanding,
+// including the team's tactics.
 func (fm *FitnessManager) CalculateMatchFatigue(player *Player, minutesPlayed int, matchIntensity float64) float64 {
 	if minutesPlayed == 0 {
 		return 0
@@ -55,7 +80,7 @@ func (fm *FitnessManager) CalculateMatchFatigue(player *Player, minutesPlayed in
 		fatigue *= 1.0
 	}
 
-	return math.Min(fatigue, 60) // Cap maximum fatigue
+	return math.Min(fatigue, maxMatchFatigue)
 }
 
 // CalculateDailyRecovery calculates fitness recovery per day
@@ -80,6 +105,11 @@ func (fm *FitnessManager) CalculateDailyRecovery(player *Player, trainingIntensi
 	// Professionalism helps recovery
 	recovery *= 1 + (float64(player.Attributes.Professionalism) / 200)
 
+	// Injured players recover more slowly depending on the injury
+	if player.CurrentInjury != nil {
+		recovery *= player.CurrentInjury.Type.Profile().RecoveryRate
+	}
+
 	return recovery
 }
 
@@ -98,8 +128,13 @@ func (fm *FitnessManager) CalculateInjuryRisk(player *Player) float64 {
 		risk += float64(age-30) * 0.01
 	}
 
-	// Recent injury history would increase risk
-	// (would need injury history tracking)
+	// Past injuries, muscle and ligament ones especially, tend to recur
+	for _, injury := range player.InjuryHistory {
+		risk += injury.Type.Profile().RecurrenceRisk
+	}
+
+	// Some players are simply more fragile than others
+	risk *= injuryPronenessFactor(player.Attributes.GetInjuryProneness())
 
 	return math.Min(risk, 0.5) // Cap at 50% risk
 }
@@ -115,3 +150,24 @@ func (fm *FitnessManager) ApplyDailyRecovery(player *Player, trainingIntensity f
 	recovery := fm.CalculateDailyRecovery(player, trainingIntensity)
 	player.Fitness = math.Min(100, player.Fitness+recovery)
 }
+
+// FitnessAtMinute projects a player's fitness after playing a number of minutes
+func (fm *FitnessManager) FitnessAtMinute(player *Player, minute int, intensity float64) float64 {
+	fatigue := fm.CalculateMatchFatigue(player, minute, intensity)
+	return math.Max(0, player.Fitness-fatigue)
+}
+
+// FitnessAtMinuteWithExtraTime projects a player's fitness after playing a
+// number of minutes, the last extraTimeMinutes of them in extra time
+func (fm *FitnessManager) FitnessAtMinuteWithExtraTime(player *Player, minute, extraTimeMinutes int, intensity float64) float64 {
+	if extraTimeMinutes <= 0 {
+		return fm.FitnessAtMinute(player, minute, intensity)
+	}
+	if extraTimeMinutes > minute {
+		extraTimeMinutes = minute
+	}
+
+	fatigue := fm.CalculateMatchFatigue(player, minute-extraTimeMinutes, intensity) +
+		fm.CalculateMatchFatigue(player, extraTimeMinutes, intensity*extraTimeFatigue)
+	return math.Max(0, player.Fitness-math.Min(fatigue, maxMatchFatigue))
+}
@@ -0,0 +1,32 @@
+// domain/player/fitness_test.go
+package player
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFitnessAtMinuteWithExtraTime(t *testing.T) {
+	fm := NewFitnessManager()
+	p := newTestPlayer("p", PositionMID, 26)
+
+	regulation := fm.FitnessAtMinute(p, 90, 1)
+	if got := fm.FitnessAtMinuteWithExtraTime(p, 90, 0, 1); got != regulation {
+		t.Errorf("without extra time = %.2f, want %.2f as FitnessAtMinute", got, regulation)
+	}
+
+	sameLength := fm.FitnessAtMinute(p, 120, 1)
+	afterExtraTime := fm.FitnessAtMinuteWithExtraTime(p, 120, 30, 1)
+	if afterExtraTime >= sameLength {
+		t.Errorf("after extra time = %.2f, want below %.2f for 120 regulation-paced minutes", afterExtraTime, sameLength)
+	}
+
+	// A substitute on for only the last 10 minutes cannot play 30 of extra time
+	if got, want := fm.FitnessAtMinuteWithExtraTime(p, 10, 30, 1), fm.FitnessAtMinuteWithExtraTime(p, 10, 10, 1); got != want {
+		t.Errorf("extra time longer than minutes played = %.2f, want %.2f", got, want)
+	}
+
+	if got := fm.FitnessAtMinuteWithExtraTime(p, 1000, 500, 3); math.Abs(got-(p.Fitness-maxMatchFatigue)) > 1e-9 {
+		t.Errorf("marathon fitness = %.2f, want the fatigue cap to leave %.2f", got, p.Fitness-maxMatchFatigue)
+	}
+}
@@ -0,0 +1,103 @@
+// domain/player/goals.go
+package player
+
+import (
+	"fmt"
+	"time"
+)
+
+// GoalType categorizes how a goal was scored
+type GoalType string
+
+const (
+	GoalOpenPlay GoalType = "open_play"
+	GoalPenalty  GoalType = "penalty"
+	GoalFreeKick GoalType = "free_kick"
+	GoalHeader   GoalType = "header"
+)
+
+// GoalBreakdown counts a player's goals by type
+type GoalBreakdown struct {
+	OpenPlay  int
+	Penalties int
+	FreeKicks int
+	Headers   int
+}
+
+// Total returns the goals across all types
+func (b GoalBreakdown) Total() int {
+	return b.OpenPlay + b.Penalties + b.FreeKicks + b.Headers
+}
+
+// add counts goals of a type, treating unknown types as open play
+func (b *GoalBreakdown) add(goalType GoalType, goals int) {
+	switch goalType {
+	case GoalPenalty:
+		b.Penalties += goals
+	case GoalFreeKick:
+		b.FreeKicks += goals
+	case GoalHeader:
+		b.Headers += goals
+	default:
+		b.OpenPlay += goals
+	}
+}
+
+// creditGoal counts one goal of a type in the career and season totals
+func (p *Player) creditGoal(goalType GoalType) {
+	p.CareerStats.TotalGoals++
+	p.CareerStats.GoalTypes.add(goalType, 1)
+	p.CareerStats.CurrentSeason.Goals++
+}
+
+// GoalBreakdown returns the player's career goals by type
+func (p *Player) GoalBreakdown() GoalBreakdown {
+	return p.CareerStats.GoalTypes
+}
+
+// GoalRecord links a goal to its scorer and assister
+type GoalRecord struct {
+	Minute     int
+	Type       GoalType
+	ScorerID   PlayerID
+	AssisterID PlayerID // Empty when unassisted
+}
+
+// RecordGoal credits an open-play goal to the scorer and an assist to the
+// assister in one step so both players' stats stay consistent. The assister
+// may be nil. Use this per goal instead of passing goal and assist counts
+// to UpdateMatchStats, which would count them twice.
+func RecordGoal(scorer, assister *Player, minute int) (GoalRecord, error) {
+	return RecordTypedGoal(scorer, assister, minute, GoalOpenPlay)
+}
+
+// RecordTypedGoal is RecordGoal for a goal of a known type
+func RecordTypedGoal(scorer, assister *Player, minute int, goalType GoalType) (GoalRecord, error) {
+	if scorer == nil {
+		return GoalRecord{}, fmt.Errorf("goal must have a scorer")
+	}
+	if minute < 0 {
+		return GoalRecord{}, fmt.Errorf("invalid goal minute %d", minute)
+	}
+	if assister != nil && assister.ID == scorer.ID {
+		return GoalRecord{}, fmt.Errorf("player %s cannot assist their own goal", scorer.ID)
+	}
+
+	record := GoalRecord{
+		Minute:   minute,
+		Type:     goalType,
+		ScorerID: scorer.ID,
+	}
+
+	scorer.creditGoal(goalType)
+	scorer.UpdatedAt = time.Now()
+
+	if assister != nil {
+		assister.CareerStats.TotalAssists++
+		assister.CareerStats.CurrentSeason.Assists++
+		assister.UpdatedAt = time.Now()
+		record.AssisterID = assister.ID
+	}
+
+	return record, nil
+}
@@ -0,0 +1,132 @@
+// domain/player/goals_test.go
+package player
+
+import (
+	"testing"
+	"time"
+)
+
+// newTestPlayer creates a player of the given age in a position
+func newTestPlayer(id string, pos Position, age int) *Player {
+	return NewPlayer(PlayerID(id), "Test", id, pos, time.Now().AddDate(-age, 0, -1))
+}
+
+func TestGoalBreakdownByType(t *testing.T) {
+	tests := []struct {
+		name  string
+		goals []GoalType
+		want  GoalBreakdown
+	}{
+		{"none", nil, GoalBreakdown{}},
+		{"open play", []GoalType{GoalOpenPlay}, GoalBreakdown{OpenPlay: 1}},
+		{"mixed", []GoalType{GoalPenalty, GoalHeader, GoalFreeKick, GoalPenalty}, GoalBreakdown{Penalties: 2, Headers: 1, FreeKicks: 1}},
+		{"unknown falls back to open play", []GoalType{"", "volley"}, GoalBreakdown{OpenPlay: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionFWD, 25)
+			if _, err := p.UpdateMatchStatsWithGoals(tt.goals, 0, MatchCards{}, 7); err != nil {
+				t.Fatalf("UpdateMatchStatsWithGoals: %v", err)
+			}
+
+			if got := p.GoalBreakdown(); got != tt.want {
+				t.Errorf("GoalBreakdown() = %+v, want %+v", got, tt.want)
+			}
+			if got := p.CareerStats.TotalGoals; got != len(tt.goals) {
+				t.Errorf("TotalGoals = %d, want %d", got, len(tt.goals))
+			}
+			if got := p.CareerStats.CurrentSeason.Goals; got != len(tt.goals) {
+				t.Errorf("season goals = %d, want %d", got, len(tt.goals))
+			}
+		})
+	}
+}
+
+func TestUpdateMatchStatsCountsUntypedGoalsAsOpenPlay(t *testing.T) {
+	p := newTestPlayer("p", PositionFWD, 25)
+	if _, err := p.UpdateMatchStats(3, 1, 0, 0, 8); err != nil {
+		t.Fatalf("UpdateMatchStats: %v", err)
+	}
+
+	if got, want := p.GoalBreakdown(), (GoalBreakdown{OpenPlay: 3}); got != want {
+		t.Errorf("GoalBreakdown() = %+v, want %+v", got, want)
+	}
+	if got := p.CareerStats.TotalAssists; got != 1 {
+		t.Errorf("TotalAssists = %d, want 1", got)
+	}
+}
+
+func TestRecordTypedGoal(t *testing.T) {
+	scorer := newTestPlayer("s", PositionFWD, 25)
+	assister := newTestPlayer("a", PositionMID, 25)
+
+	record, err := RecordTypedGoal(scorer, assister, 12, GoalHeader)
+	if err != nil {
+		t.Fatalf("RecordTypedGoal: %v", err)
+	}
+	if record.Type != GoalHeader || record.AssisterID != "a" {
+		t.Errorf("record = %+v", record)
+	}
+	if got := scorer.GoalBreakdown().Headers; got != 1 {
+		t.Errorf("Headers = %d, want 1", got)
+	}
+	if got := assister.CareerStats.TotalAssists; got != 1 {
+		t.Errorf("assister TotalAssists = %d, want 1", got)
+	}
+
+	if _, err := RecordTypedGoal(scorer, scorer, 20, GoalOpenPlay); err == nil {
+		t.Error("RecordTypedGoal allowed a self-assist")
+	}
+	if _, err := RecordTypedGoal(nil, assister, 20, GoalOpenPlay); err == nil {
+		t.Error("RecordTypedGoal allowed a missing scorer")
+	}
+}
+
+func TestRecordGoal(t *testing.T) {
+	tests := []struct {
+		name        string
+		assister    string // "" for none, "s" for the scorer
+		minute      int
+		wantErr     bool
+		wantGoals   int
+		wantAssists int
+	}{
+		{"unassisted", "", 30, false, 1, 0},
+		{"assisted", "a", 30, false, 1, 1},
+		{"self-assist", "s", 30, true, 0, 0},
+		{"negative minute", "a", -1, true, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scorer := newTestPlayer("s", PositionFWD, 25)
+			mate := newTestPlayer("a", PositionMID, 25)
+			var assister *Player
+			switch tt.assister {
+			case "s":
+				assister = scorer
+			case "a":
+				assister = mate
+			}
+
+			record, err := RecordGoal(scorer, assister, tt.minute)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RecordGoal() error = %v, want error %v", err, tt.wantErr)
+			}
+			if err == nil && (record.ScorerID != "s" || record.Type != GoalOpenPlay || record.Minute != tt.minute) {
+				t.Errorf("record = %+v", record)
+			}
+
+			if got := scorer.CareerStats.TotalGoals; got != tt.wantGoals {
+				t.Errorf("scorer goals = %d, want %d", got, tt.wantGoals)
+			}
+			if got := scorer.CareerStats.TotalAssists + mate.CareerStats.TotalAssists; got != tt.wantAssists {
+				t.Errorf("assists credited = %d, want %d", got, tt.wantAssists)
+			}
+			if got := scorer.CareerStats.TotalAssists; got != 0 {
+				t.Errorf("scorer credited with %d assists", got)
+			}
+		})
+	}
+}
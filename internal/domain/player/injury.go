@@ -0,0 +1,183 @@
+// domain/player/injury.go
+package player
+
+import (
+	"math"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+// Injury proneness scale
+const (
+	averageInjuryProneness = 50
+	fragileProneness       = 65 // Proneness from which each injury worsens it
+	fragilityGrowth        = 2  // Proneness gained per injury once fragile
+)
+
+// InjuryType classifies an injury by its nature and severity
+type InjuryType string
+
+const (
+	InjuryKnock    InjuryType = "knock"
+	InjuryMuscle   InjuryType = "muscle"
+	InjuryLigament InjuryType = "ligament"
+	InjuryFracture InjuryType = "fracture"
+)
+
+// injuryPronenessFactor scales injury risk so an average player is
+// unaffected, the most robust carry a fifth of the risk and the most
+// fragile double it
+func injuryPronenessFactor(proneness int) float64 {
+	return math.Max(0.2, float64(proneness)/averageInjuryProneness)
+}
+
+// InjuryProfile describes how an injury type plays out
+type InjuryProfile struct {
+	MinDays        int
+	MaxDays        int
+	AttributeRisk  float64 // Chance of losing attribute points permanently
+	RecoveryRate   float64 // Fitness recovery multiplier while injured
+	RecurrenceRisk float64 // Added injury risk for each past injury of this type
+	Likelihood     float64 // Relative frequency among injuries
+}
+
+// injuryProfiles holds the profile of each injury type
+var injuryProfiles = map[InjuryType]InjuryProfile{
+	InjuryKnock:    {MinDays: 1, MaxDays: 7, AttributeRisk: 0, RecoveryRate: 0.8, RecurrenceRisk: 0, Likelihood: 0.45},
+	InjuryMuscle:   {MinDays: 7, MaxDays: 35, AttributeRisk: 0.05, RecoveryRate: 0.6, RecurrenceRisk: 0.02, Likelihood: 0.35},
+	InjuryLigament: {MinDays: 60, MaxDays: 270, AttributeRisk: 0.4, RecoveryRate: 0.3, RecurrenceRisk: 0.03, Likelihood: 0.12},
+	InjuryFracture: {MinDays: 42, MaxDays: 120, AttributeRisk: 0.15, RecoveryRate: 0.4, RecurrenceRisk: 0.01, Likelihood: 0.08},
+}
+
+// injuryTypes fixes the order injury types are rolled in
+var injuryTypes = []InjuryType{InjuryKnock, InjuryMuscle, InjuryLigament, InjuryFracture}
+
+// Profile returns the injury type's profile, treating unknown types as knocks
+func (t InjuryType) Profile() InjuryProfile {
+	if profile, ok := injuryProfiles[t]; ok {
+		return profile
+	}
+	return injuryProfiles[InjuryKnock]
+}
+
+// Injury is a specific injury suffered by a player
+type Injury struct {
+	Type          InjuryType
+	Days          int            // Days out from the time of injury
+	AttributeLoss map[string]int // Permanent attribute damage
+	OccurredAt    time.Time
+}
+
+// RollInjury picks an injury's type, length and lasting damage, with older
+// players taking longer to recover
+func RollInjury(player *Player, seed int64) Injury {
+	return RollInjuryWithSource(player, common.NewRandSource(seed))
+}
+
+// RollInjuryWithSource picks an injury like RollInjury, drawing from the
+// given source
+func RollInjuryWithSource(player *Player, rng common.RandSource) Injury {
+	injuryType := injuryTypes[len(injuryTypes)-1]
+	roll := rng.Float64()
+	for _, t := range injuryTypes {
+		if roll < injuryProfiles[t].Likelihood {
+			injuryType = t
+			break
+		}
+		roll -= injuryProfiles[t].Likelihood
+	}
+
+	profile := injuryType.Profile()
+	days := profile.MinDays + rng.Intn(profile.MaxDays-profile.MinDays+1)
+	if age := player.Age(); age > 30 {
+		days += days * (age - 30) / 20
+	}
+
+	injury := Injury{
+		Type:          injuryType,
+		Days:          days,
+		AttributeLoss: make(map[string]int),
+		OccurredAt:    time.Now(),
+	}
+	if rng.Float64() < profile.AttributeRisk {
+		// Serious injuries mostly rob players of pace and endurance
+		attr := []string{"Speed", "Stamina"}[rng.Intn(2)]
+		injury.AttributeLoss[attr] = 1 + rng.Intn(3)
+	}
+
+	return injury
+}
+
+// ApplyInjury rules the player out, records the injury in their history
+// and applies any lasting damage. A retired player stays retired.
+func (p *Player) ApplyInjury(injury Injury) error {
+	previous := p.CurrentInjury
+	p.CurrentInjury = &injury
+	if p.Status != StatusRetired {
+		if err := p.SetStatus(StatusInjured); err != nil {
+			p.CurrentInjury = previous
+			return err
+		}
+	}
+
+	for name, loss := range injury.AttributeLoss {
+		if v, ok := p.Attributes.Get(name); ok {
+			p.Attributes.Set(name, clampAttribute(v-loss))
+		}
+	}
+	p.refreshRating()
+	p.Attributes.Quality = p.GetOverallRating()
+
+	// Each injury to an already fragile player leaves them more so
+	if proneness := p.Attributes.GetInjuryProneness(); proneness >= fragileProneness {
+		p.Attributes.InjuryProneness = int(math.Min(float64(proneness+fragilityGrowth), 100))
+	}
+
+	p.InjuryHistory = append(p.InjuryHistory, injury)
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// RecoverFromInjury counts down an injury over a number of days, returning
+// the player to availability once it has healed, or to suspension if a ban
+// is still outstanding. It reports whether the player is fit again.
+func (p *Player) RecoverFromInjury(days int) (bool, error) {
+	if p.CurrentInjury == nil {
+		return p.Status != StatusInjured, nil
+	}
+
+	remaining := *p.CurrentInjury
+	remaining.Days -= days
+	if remaining.Days > 0 {
+		p.CurrentInjury = &remaining
+		return false, nil
+	}
+
+	p.CurrentInjury = nil
+	p.UpdatedAt = time.Now()
+	if p.Status != StatusInjured {
+		return true, nil
+	}
+
+	next := StatusAvailable
+	if p.SuspensionGames > 0 {
+		next = StatusSuspended
+	}
+	if err := p.SetStatus(next); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// clone copies the injury without sharing its attribute map
+func (i Injury) clone() Injury {
+	if i.AttributeLoss != nil {
+		loss := make(map[string]int, len(i.AttributeLoss))
+		for k, v := range i.AttributeLoss {
+			loss[k] = v
+		}
+		i.AttributeLoss = loss
+	}
+	return i
+}
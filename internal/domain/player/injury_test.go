@@ -0,0 +1,200 @@
+// domain/player/injury_test.go
+package player
+
+import (
+	"math"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+// rollInjuries draws many injuries for a player, grouped by type
+func rollInjuries(p *Player, n int) map[InjuryType][]Injury {
+	rng := common.NewRandSource(6)
+	rolled := make(map[InjuryType][]Injury)
+	for i := 0; i < n; i++ {
+		injury := RollInjuryWithSource(p, rng)
+		rolled[injury.Type] = append(rolled[injury.Type], injury)
+	}
+	return rolled
+}
+
+func TestRollInjuryDurationsByType(t *testing.T) {
+	rolled := rollInjuries(newTestPlayer("p", PositionMID, 25), 5000)
+
+	for _, injuryType := range injuryTypes {
+		t.Run(string(injuryType), func(t *testing.T) {
+			injuries := rolled[injuryType]
+			if len(injuries) == 0 {
+				t.Fatal("never rolled")
+			}
+
+			profile := injuryType.Profile()
+			damaged := 0
+			for _, injury := range injuries {
+				if injury.Days < profile.MinDays || injury.Days > profile.MaxDays {
+					t.Fatalf("Days = %d, want %d-%d", injury.Days, profile.MinDays, profile.MaxDays)
+				}
+				if len(injury.AttributeLoss) > 0 {
+					damaged++
+				}
+			}
+
+			share := float64(damaged) / float64(len(injuries))
+			if profile.AttributeRisk == 0 && damaged > 0 {
+				t.Errorf("%d of %d injuries left lasting damage, want none", damaged, len(injuries))
+			}
+			if profile.AttributeRisk > 0.1 && (share < profile.AttributeRisk/2 || share > profile.AttributeRisk*1.5) {
+				t.Errorf("%.2f of injuries left lasting damage, want about %.2f", share, profile.AttributeRisk)
+			}
+		})
+	}
+}
+
+func TestRollInjurySeverityOrder(t *testing.T) {
+	tests := []struct {
+		shorter, longer InjuryType
+	}{
+		{InjuryKnock, InjuryMuscle},
+		{InjuryMuscle, InjuryFracture},
+		{InjuryFracture, InjuryLigament},
+	}
+
+	for _, tt := range tests {
+		shorter, longer := tt.shorter.Profile(), tt.longer.Profile()
+		if shorter.MinDays >= longer.MinDays || shorter.MinDays+shorter.MaxDays >= longer.MinDays+longer.MaxDays {
+			t.Errorf("%s (%d-%d days) should be a shorter layoff than %s (%d-%d days)",
+				tt.shorter, shorter.MinDays, shorter.MaxDays, tt.longer, longer.MinDays, longer.MaxDays)
+		}
+	}
+	if knock := InjuryKnock.Profile(); knock.MaxDays > 7 {
+		t.Errorf("a knock lasts up to %d days, want at most a week", knock.MaxDays)
+	}
+	if ligament := InjuryLigament.Profile(); ligament.MinDays < 60 || ligament.AttributeRisk < 0.25 {
+		t.Errorf("ligament profile %+v, want a long layoff and real attribute risk", ligament)
+	}
+}
+
+func TestRollInjuryOlderPlayersTakeLonger(t *testing.T) {
+	young := rollInjuries(newTestPlayer("young", PositionMID, 25), 3000)
+	old := rollInjuries(newTestPlayer("old", PositionMID, 40), 3000)
+
+	average := func(injuries []Injury) float64 {
+		total := 0
+		for _, injury := range injuries {
+			total += injury.Days
+		}
+		return float64(total) / float64(len(injuries))
+	}
+
+	for _, injuryType := range injuryTypes {
+		profile := injuryType.Profile()
+		for _, injury := range old[injuryType] {
+			if injury.Days > profile.MaxDays*3/2 {
+				t.Fatalf("%s lasted %d days for a 40-year-old, want at most %d", injuryType, injury.Days, profile.MaxDays*3/2)
+			}
+		}
+		if average(old[injuryType]) <= average(young[injuryType]) {
+			t.Errorf("%s average %.1f days at 40, %.1f at 25; want longer for the older player",
+				injuryType, average(old[injuryType]), average(young[injuryType]))
+		}
+	}
+}
+
+func TestInjuryTypeProfileFallsBackToKnock(t *testing.T) {
+	if got := InjuryType("sprain").Profile(); got != InjuryKnock.Profile() {
+		t.Errorf("unknown type profile = %+v, want the knock profile", got)
+	}
+}
+
+func TestCalculateInjuryRiskScalesWithProneness(t *testing.T) {
+	tests := []struct {
+		name      string
+		proneness int
+		want      float64
+	}{
+		{"unknown counts as average", 0, 0.03},
+		{"average", 50, 0.03},
+		{"most robust", 1, 0.006},
+		{"robust", 25, 0.015},
+		{"glass", 100, 0.06},
+	}
+
+	fm := NewFitnessManager()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, 33) // Age alone puts the risk at 0.03
+			p.Fitness = 100
+			p.Attributes.InjuryProneness = tt.proneness
+
+			if got := fm.CalculateInjuryRisk(p); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("CalculateInjuryRisk() = %.4f, want %.4f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInjuryPronePlayersBreakDownMoreOften(t *testing.T) {
+	// countInjuries plays a season of matches, injuring the player whenever
+	// a roll falls under their risk and letting them heal before the next
+	countInjuries := func(proneness int) int {
+		fm := NewFitnessManagerWithSource(common.NewRandSource(8))
+		rng := common.NewRandSource(8)
+		p := newTestPlayer("p", PositionMID, 32)
+		p.Attributes.InjuryProneness = proneness
+
+		injuries := 0
+		for match := 0; match < 1000; match++ {
+			p.Fitness = 100
+			fm.ApplyMatchFitness(p, 90, 1)
+			if rng.Float64() >= fm.CalculateInjuryRisk(p) {
+				continue
+			}
+			injury := RollInjuryWithSource(p, rng)
+			if err := p.ApplyInjury(injury); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := p.RecoverFromInjury(injury.Days); err != nil {
+				t.Fatal(err)
+			}
+			injuries++
+		}
+		return injuries
+	}
+
+	robust, prone := countInjuries(20), countInjuries(90)
+	if robust == 0 {
+		t.Fatal("robust player never injured; the comparison says nothing")
+	}
+	if float64(prone) < float64(robust)*2 {
+		t.Errorf("injury-prone player injured %d times, robust %d; want at least twice as often", prone, robust)
+	}
+}
+
+func TestApplyInjuryEscalatesFragility(t *testing.T) {
+	tests := []struct {
+		name      string
+		proneness int
+		want      int
+	}{
+		{"unknown stays unknown", 0, 0},
+		{"average is unaffected", 50, 50},
+		{"just below fragile", fragileProneness - 1, fragileProneness - 1},
+		{"fragile worsens", fragileProneness, fragileProneness + fragilityGrowth},
+		{"capped at 100", 99, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, 26)
+			p.Attributes.InjuryProneness = tt.proneness
+
+			if err := p.ApplyInjury(Injury{Type: InjuryKnock, Days: 3}); err != nil {
+				t.Fatal(err)
+			}
+			if got := p.Attributes.InjuryProneness; got != tt.want {
+				t.Errorf("InjuryProneness = %d after an injury, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+// domain/player/loan.go
+package player
+
+import (
+	"math"
+	"time"
+)
+
+// LoanDeal records the terms of a player's loan away from their club
+type LoanDeal struct {
+	ParentTeamID string
+	WageShare    float64 // Portion of the wage paid by the loan club (0-1)
+	StartDate    time.Time
+	EndDate      time.Time
+}
+
+// HasEnded checks if the loan term is over at the given time
+func (l *LoanDeal) HasEnded(at time.Time) bool {
+	return !at.Before(l.EndDate)
+}
+
+// WageCost returns the weekly wage the player's own club pays
+func (p *Player) WageCost() int64 {
+	if p.Loan == nil || p.Status != StatusOnLoan {
+		return p.Wage
+	}
+	return int64(math.Round(float64(p.Wage) * (1 - p.Loan.WageShare)))
+}
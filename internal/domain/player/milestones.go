@@ -0,0 +1,85 @@
+// domain/player/milestones.go
+package player
+
+// MilestoneType represents a category of career achievement
+type MilestoneType string
+
+const (
+	MilestoneDebut        MilestoneType = "debut"
+	MilestoneAppearances  MilestoneType = "appearances"
+	MilestoneFirstGoal    MilestoneType = "first_goal"
+	MilestoneGoals        MilestoneType = "goals"
+	MilestoneAssists      MilestoneType = "assists"
+	MilestoneFirstRedCard MilestoneType = "first_red_card"
+)
+
+// Milestone is a career achievement derived from career stats
+type Milestone struct {
+	Type        MilestoneType
+	Threshold   int
+	Description string
+}
+
+// milestoneRule defines when a milestone is reached
+type milestoneRule struct {
+	milestoneType MilestoneType
+	threshold     int
+	counter       func(CareerStats) int
+	description   string
+}
+
+// milestoneRules lists every tracked milestone
+var milestoneRules = []milestoneRule{
+	{MilestoneDebut, 1, matchesCounter, "Made professional debut"},
+	{MilestoneAppearances, 100, matchesCounter, "100 career appearances"},
+	{MilestoneAppearances, 250, matchesCounter, "250 career appearances"},
+	{MilestoneAppearances, 500, matchesCounter, "500 career appearances"},
+	{MilestoneFirstGoal, 1, goalsCounter, "Scored first career goal"},
+	{MilestoneGoals, 50, goalsCounter, "50 career goals"},
+	{MilestoneGoals, 100, goalsCounter, "100 career goals"},
+	{MilestoneGoals, 200, goalsCounter, "200 career goals"},
+	{MilestoneAssists, 50, assistsCounter, "50 career assists"},
+	{MilestoneAssists, 100, assistsCounter, "100 career assists"},
+	{MilestoneFirstRedCard, 1, redCardsCounter, "Received first red card"},
+}
+
+func matchesCounter(s CareerStats) int  { return s.TotalMatches }
+func goalsCounter(s CareerStats) int    { return s.TotalGoals }
+func assistsCounter(s CareerStats) int  { return s.TotalAssists }
+func redCardsCounter(s CareerStats) int { return s.TotalRedCards }
+
+// CheckMilestones returns every milestone the player has reached
+func (p *Player) CheckMilestones() []Milestone {
+	return achievedMilestones(p.CareerStats)
+}
+
+// achievedMilestones evaluates all milestone rules against career stats
+func achievedMilestones(stats CareerStats) []Milestone {
+	achieved := []Milestone{}
+	for _, rule := range milestoneRules {
+		if rule.counter(stats) >= rule.threshold {
+			achieved = append(achieved, rule.milestone())
+		}
+	}
+	return achieved
+}
+
+// newMilestones returns milestones reached in after but not in before
+func newMilestones(before, after CareerStats) []Milestone {
+	crossed := []Milestone{}
+	for _, rule := range milestoneRules {
+		if rule.counter(before) < rule.threshold && rule.counter(after) >= rule.threshold {
+			crossed = append(crossed, rule.milestone())
+		}
+	}
+	return crossed
+}
+
+// milestone builds the milestone described by a rule
+func (r milestoneRule) milestone() Milestone {
+	return Milestone{
+		Type:        r.milestoneType,
+		Threshold:   r.threshold,
+		Description: r.description,
+	}
+}
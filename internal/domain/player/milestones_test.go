@@ -0,0 +1,105 @@
+// domain/player/milestones_test.go
+package player
+
+import (
+	"reflect"
+	"testing"
+)
+
+// milestoneKeys summarises milestones as type and threshold pairs
+func milestoneKeys(milestones []Milestone) []Milestone {
+	keys := []Milestone{}
+	for _, m := range milestones {
+		keys = append(keys, Milestone{Type: m.Type, Threshold: m.Threshold})
+	}
+	return keys
+}
+
+func TestUpdateMatchStatsCrossesGoalMilestone(t *testing.T) {
+	tests := []struct {
+		name        string
+		goalsBefore int
+		goals       int
+		want        []Milestone
+	}{
+		{"one short of 100", 98, 1, []Milestone{}},
+		{"lands exactly on 100", 99, 1, []Milestone{{Type: MilestoneGoals, Threshold: 100}}},
+		{"hat-trick past 100", 98, 3, []Milestone{{Type: MilestoneGoals, Threshold: 100}}},
+		{"already past 100", 100, 1, []Milestone{}},
+		{"no goals at 99", 99, 0, []Milestone{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionFWD, 30)
+			p.CareerStats.TotalMatches = 300
+			p.CareerStats.TotalGoals = tt.goalsBefore
+
+			update, err := p.UpdateMatchStats(tt.goals, 0, 0, 0, 7)
+			if err != nil {
+				t.Fatalf("UpdateMatchStats: %v", err)
+			}
+			if got := milestoneKeys(update.Milestones); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Milestones = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateMatchStatsFirstMilestones(t *testing.T) {
+	p := newTestPlayer("p", PositionFWD, 18)
+
+	update, err := p.UpdateMatchStats(1, 0, 0, 1, 6)
+	if err != nil {
+		t.Fatalf("UpdateMatchStats: %v", err)
+	}
+	want := []Milestone{
+		{Type: MilestoneDebut, Threshold: 1},
+		{Type: MilestoneFirstGoal, Threshold: 1},
+		{Type: MilestoneFirstRedCard, Threshold: 1},
+	}
+	if got := milestoneKeys(update.Milestones); !reflect.DeepEqual(got, want) {
+		t.Errorf("debut Milestones = %v, want %v", got, want)
+	}
+
+	update, err = p.UpdateMatchStats(1, 0, 0, 1, 6)
+	if err != nil {
+		t.Fatalf("UpdateMatchStats: %v", err)
+	}
+	if len(update.Milestones) != 0 {
+		t.Errorf("second match Milestones = %v, want none", update.Milestones)
+	}
+}
+
+func TestCheckMilestones(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats CareerStats
+		want  []Milestone
+	}{
+		{"new player", CareerStats{}, []Milestone{}},
+		{
+			"veteran",
+			CareerStats{TotalMatches: 500, TotalGoals: 100, TotalAssists: 49},
+			[]Milestone{
+				{Type: MilestoneDebut, Threshold: 1},
+				{Type: MilestoneAppearances, Threshold: 100},
+				{Type: MilestoneAppearances, Threshold: 250},
+				{Type: MilestoneAppearances, Threshold: 500},
+				{Type: MilestoneFirstGoal, Threshold: 1},
+				{Type: MilestoneGoals, Threshold: 50},
+				{Type: MilestoneGoals, Threshold: 100},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, 30)
+			p.CareerStats = tt.stats
+			if got := milestoneKeys(p.CheckMilestones()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CheckMilestones() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
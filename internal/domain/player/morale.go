@@ -0,0 +1,56 @@
+// domain/player/morale.go
+package player
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	resultMoraleSwing = 3.0  // Morale change for a narrow win or loss
+	heavyResultMargin = 3    // Goal margin that makes a result heavy
+	heavyWinFactor    = 1.5  // Extra lift from a big win
+	heavyLossFactor   = 2.0  // Extra damage from a thrashing
+	featuredFactor    = 1.5  // Players who featured feel a win more
+	benchLossFactor   = 0.75 // Players who sat out feel a loss less
+	moraleDecay       = 0.1  // Share of the gap to neutral morale closed each match
+)
+
+// ApplyResultMorale adjusts morale after a narrow result ("W", "D" or
+// "L"). Wins lift morale, more so for players who featured, and losses
+// dent it.
+func (p *Player) ApplyResultMorale(result string, wasStarter bool) {
+	p.ApplyResultMoraleByMargin(result, wasStarter, 1)
+}
+
+// ApplyResultMoraleByMargin adjusts morale after a result won or lost by a
+// given goal margin, so a big win lifts spirits further and a thrashing
+// hurts more. Morale first drifts back towards its usual level, so old
+// results fade, and stays within 0-100.
+func (p *Player) ApplyResultMoraleByMargin(result string, wasStarter bool, margin int) {
+	morale := p.Morale + (neutralMorale-p.Morale)*moraleDecay
+
+	switch result {
+	case "W":
+		change := resultMoraleSwing
+		if margin >= heavyResultMargin {
+			change *= heavyWinFactor
+		}
+		if wasStarter {
+			change *= featuredFactor
+		}
+		morale += change
+	case "L":
+		change := resultMoraleSwing
+		if margin >= heavyResultMargin {
+			change *= heavyLossFactor
+		}
+		if !wasStarter {
+			change *= benchLossFactor
+		}
+		morale -= change
+	}
+
+	p.Morale = math.Max(0, math.Min(morale, 100))
+	p.UpdatedAt = time.Now()
+}
@@ -0,0 +1,92 @@
+// domain/player/morale_test.go
+package player
+
+import (
+	"math"
+	"testing"
+)
+
+func TestApplyResultMoraleByMargin(t *testing.T) {
+	tests := []struct {
+		name       string
+		result     string
+		starter    bool
+		margin     int
+		wantChange float64
+	}{
+		{"narrow win, featured", "W", true, 1, 4.5},
+		{"narrow win, on the bench", "W", false, 1, 3},
+		{"big win, featured", "W", true, 4, 6.75},
+		{"big win, on the bench", "W", false, 4, 4.5},
+		{"draw", "D", true, 0, 0},
+		{"narrow loss, featured", "L", true, 1, -3},
+		{"narrow loss, on the bench", "L", false, 1, -2.25},
+		{"thrashing, featured", "L", true, 5, -6},
+		{"thrashing, on the bench", "L", false, 5, -4.5},
+		{"unknown result", "?", true, 3, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, 26)
+			p.Morale = neutralMorale // No drift, so only the result counts
+
+			p.ApplyResultMoraleByMargin(tt.result, tt.starter, tt.margin)
+			if got := p.Morale - neutralMorale; math.Abs(got-tt.wantChange) > 1e-9 {
+				t.Errorf("morale change = %.2f, want %.2f", got, tt.wantChange)
+			}
+		})
+	}
+}
+
+func TestBigWinVersusThrashing(t *testing.T) {
+	winner := newTestPlayer("winner", PositionMID, 26)
+	loser := newTestPlayer("loser", PositionMID, 26)
+	winner.Morale, loser.Morale = neutralMorale, neutralMorale
+
+	winner.ApplyResultMoraleByMargin("W", true, 5)
+	loser.ApplyResultMoraleByMargin("L", true, 5)
+
+	lift, damage := winner.Morale-neutralMorale, neutralMorale-loser.Morale
+	if lift <= resultMoraleSwing || damage <= resultMoraleSwing {
+		t.Errorf("5-0: lift %.2f, damage %.2f; want both beyond a narrow result's %.1f", lift, damage, resultMoraleSwing)
+	}
+}
+
+func TestApplyResultMoraleDecaysAndClamps(t *testing.T) {
+	tests := []struct {
+		name   string
+		morale float64
+		result string
+		margin int
+		want   float64
+	}{
+		{"high morale drifts down after a draw", 95, "D", 0, 93},
+		{"low morale drifts up after a draw", 35, "D", 0, 39},
+		{"capped at 100", 99, "W", 5, 100},
+		{"drift outweighs a thrashing at rock bottom", 0, "L", 5, 1.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, 26)
+			p.Morale = tt.morale
+
+			p.ApplyResultMoraleByMargin(tt.result, true, tt.margin)
+			if math.Abs(p.Morale-tt.want) > 1e-9 {
+				t.Errorf("Morale = %.2f, want %.2f", p.Morale, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyResultMoraleIsNarrow(t *testing.T) {
+	narrow := newTestPlayer("a", PositionMID, 26)
+	byMargin := newTestPlayer("b", PositionMID, 26)
+
+	narrow.ApplyResultMorale("L", false)
+	byMargin.ApplyResultMoraleByMargin("L", false, 1)
+	if narrow.Morale != byMargin.Morale {
+		t.Errorf("ApplyResultMorale() left morale %.2f, want %.2f as a one-goal result", narrow.Morale, byMargin.Morale)
+	}
+}
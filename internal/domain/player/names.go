@@ -0,0 +1,106 @@
+// domain/player/names.go
+package player
+
+import (
+	"sort"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+// NameGenerator produces names and nationalities for generated players
+type NameGenerator interface {
+	Generate(rng common.RandSource) GeneratedName
+}
+
+// GeneratedName is a generated player's identity
+type GeneratedName struct {
+	FirstName   string
+	LastName    string
+	Nationality string
+}
+
+// namePool holds the plausible names for one nationality
+type namePool struct {
+	first []string
+	last  []string
+}
+
+var namePools = map[string]namePool{
+	"Brazil": {
+		first: []string{"Gabriel", "Lucas", "Mateus", "Rafael", "Thiago", "Vinicius", "Bruno", "Caio", "Diego", "Renan"},
+		last:  []string{"Silva", "Santos", "Oliveira", "Souza", "Pereira", "Costa", "Rodrigues", "Almeida", "Carvalho", "Ribeiro"},
+	},
+	"England": {
+		first: []string{"Harry", "Jack", "James", "Oliver", "George", "Callum", "Mason", "Declan", "Reece", "Tom"},
+		last:  []string{"Smith", "Walker", "Wright", "Taylor", "Hughes", "Clarke", "Palmer", "Barnes", "Turner", "Wilson"},
+	},
+	"France": {
+		first: []string{"Antoine", "Hugo", "Lucas", "Theo", "Jules", "Mathis", "Adrien", "Kylian", "Olivier", "Benoit"},
+		last:  []string{"Martin", "Bernard", "Dubois", "Laurent", "Lefevre", "Moreau", "Girard", "Fournier", "Mercier", "Rousseau"},
+	},
+	"Germany": {
+		first: []string{"Lukas", "Leon", "Jonas", "Felix", "Niklas", "Florian", "Kai", "Julian", "Timo", "Maximilian"},
+		last:  []string{"Muller", "Schmidt", "Schneider", "Fischer", "Weber", "Wagner", "Becker", "Hoffmann", "Koch", "Richter"},
+	},
+	"Italy": {
+		first: []string{"Luca", "Marco", "Alessandro", "Federico", "Lorenzo", "Matteo", "Nicolo", "Davide", "Andrea", "Giorgio"},
+		last:  []string{"Rossi", "Russo", "Ferrari", "Esposito", "Bianchi", "Romano", "Colombo", "Ricci", "Marino", "Greco"},
+	},
+	"Netherlands": {
+		first: []string{"Daan", "Sem", "Jesse", "Bram", "Teun", "Stefan", "Ruben", "Joost", "Wout", "Frenkie"},
+		last:  []string{"de Jong", "Jansen", "de Vries", "van Dijk", "Bakker", "Visser", "Smit", "Meijer", "Mulder", "de Boer"},
+	},
+	"Nigeria": {
+		first: []string{"Chidi", "Emeka", "Femi", "Tunde", "Victor", "Samuel", "Ademola", "Kelechi", "Wilfred", "Ahmed"},
+		last:  []string{"Okafor", "Adeyemi", "Eze", "Okonkwo", "Bakare", "Nwankwo", "Balogun", "Iwobi", "Onuachu", "Musa"},
+	},
+	"Spain": {
+		first: []string{"Pablo", "Alvaro", "Sergio", "Javier", "Daniel", "Pedro", "Marcos", "Iker", "Rodrigo", "Dani"},
+		last:  []string{"Garcia", "Fernandez", "Lopez", "Martinez", "Sanchez", "Perez", "Gomez", "Ruiz", "Torres", "Navarro"},
+	},
+}
+
+// Nationalities returns the nationalities the default generator knows, in
+// alphabetical order
+func Nationalities() []string {
+	names := make([]string, 0, len(namePools))
+	for nationality := range namePools {
+		names = append(names, nationality)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NationalNameGenerator draws names from per-nationality pools, so a
+// player's name always matches their nationality
+type NationalNameGenerator struct {
+	nationalities []string
+}
+
+// NewNameGenerator creates a generator restricted to the given
+// nationalities. Unknown nationalities are ignored; with none left, every
+// known nationality is used.
+func NewNameGenerator(nationalities ...string) *NationalNameGenerator {
+	known := []string{}
+	for _, nationality := range nationalities {
+		if _, ok := namePools[nationality]; ok {
+			known = append(known, nationality)
+		}
+	}
+	if len(known) == 0 {
+		known = Nationalities()
+	}
+	return &NationalNameGenerator{nationalities: known}
+}
+
+// Generate picks a nationality and a matching first and last name
+func (g *NationalNameGenerator) Generate(rng common.RandSource) GeneratedName {
+	nationality := g.nationalities[rng.Intn(len(g.nationalities))]
+	pool := namePools[nationality]
+
+	return GeneratedName{
+		FirstName:   pool.first[rng.Intn(len(pool.first))],
+		LastName:    pool.last[rng.Intn(len(pool.last))],
+		Nationality: nationality,
+	}
+}
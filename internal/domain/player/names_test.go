@@ -0,0 +1,122 @@
+// domain/player/names_test.go
+package player
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+// stubNames hands out a fixed identity, counting how often it is asked
+type stubNames struct {
+	calls int
+}
+
+func (s *stubNames) Generate(common.RandSource) GeneratedName {
+	s.calls++
+	return GeneratedName{FirstName: "Stub", LastName: "Prospect", Nationality: "Nowhere"}
+}
+
+// drawNames generates n names from a freshly seeded source
+func drawNames(g NameGenerator, seed int64, n int) []GeneratedName {
+	rng := common.NewRandSource(seed)
+	names := make([]GeneratedName, n)
+	for i := range names {
+		names[i] = g.Generate(rng)
+	}
+	return names
+}
+
+func TestNameGeneratorStableForSeed(t *testing.T) {
+	tests := []struct {
+		name          string
+		nationalities []string
+	}{
+		{"single nationality", []string{"Italy"}},
+		{"several nationalities", []string{"Brazil", "Nigeria", "Spain"}},
+		{"every nationality", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first := drawNames(NewNameGenerator(tt.nationalities...), 21, 25)
+			second := drawNames(NewNameGenerator(tt.nationalities...), 21, 25)
+			if !reflect.DeepEqual(first, second) {
+				t.Errorf("same seed generated different names:\n%v\n%v", first, second)
+			}
+			if other := drawNames(NewNameGenerator(tt.nationalities...), 22, 25); reflect.DeepEqual(first, other) {
+				t.Error("different seeds generated the same names")
+			}
+		})
+	}
+}
+
+func TestNameGeneratorMatchesNationality(t *testing.T) {
+	tests := []struct {
+		name          string
+		nationalities []string
+		want          []string
+	}{
+		{"restricted", []string{"Germany", "Netherlands"}, []string{"Germany", "Netherlands"}},
+		{"unknown ignored", []string{"Atlantis", "France"}, []string{"France"}},
+		{"only unknown falls back to all", []string{"Atlantis"}, Nationalities()},
+		{"none given uses all", nil, Nationalities()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := map[string]bool{}
+			for _, name := range drawNames(NewNameGenerator(tt.nationalities...), 3, 400) {
+				pool, ok := namePools[name.Nationality]
+				if !ok {
+					t.Fatalf("generated unknown nationality %q", name.Nationality)
+				}
+				if !containsName(pool.first, name.FirstName) || !containsName(pool.last, name.LastName) {
+					t.Errorf("%s %s is not a %s name", name.FirstName, name.LastName, name.Nationality)
+				}
+				seen[name.Nationality] = true
+			}
+
+			got := []string{}
+			for nationality := range seen {
+				got = append(got, nationality)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("nationalities generated = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNationalitiesSorted(t *testing.T) {
+	got := Nationalities()
+	if len(got) != len(namePools) || !sort.StringsAreSorted(got) {
+		t.Errorf("Nationalities() = %v, want all %d pools in order", got, len(namePools))
+	}
+}
+
+func TestGenerateIntakeWithNamesUsesGenerator(t *testing.T) {
+	stub := &stubNames{}
+	intake := GenerateIntakeWithNames("club", 60, common.NewRandSource(4), stub)
+
+	if stub.calls != len(intake) {
+		t.Errorf("generator called %d times for %d prospects", stub.calls, len(intake))
+	}
+	for _, p := range intake {
+		if p.FirstName != "Stub" || p.LastName != "Prospect" || p.Nationality != "Nowhere" {
+			t.Errorf("prospect %s named %s %s (%s), want the stub identity", p.ID, p.FirstName, p.LastName, p.Nationality)
+		}
+	}
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,57 @@
+// domain/player/overtraining.go
+package player
+
+import "math"
+
+const (
+	// overtrainingThreshold is the number of consecutive identical sessions
+	// a player handles before returns diminish
+	overtrainingThreshold = 3
+	// overtrainingDecay is how sharply improvement chance falls per extra
+	// session
+	overtrainingDecay = 0.25
+	// overtrainingMorale is the morale lost per extra session
+	overtrainingMorale = 1.0
+)
+
+// TrainingLoad tracks a player's run of consecutive sessions of one type
+type TrainingLoad struct {
+	Type     TrainingType
+	Sessions int
+}
+
+// record counts a session, restarting the run when the type changes, and
+// returns the length of the run
+func (tl *TrainingLoad) record(trainingType TrainingType) int {
+	if tl.Type != trainingType {
+		tl.Type = trainingType
+		tl.Sessions = 0
+	}
+	tl.Sessions++
+	return tl.Sessions
+}
+
+// Reset clears the run, as after a rest
+func (tl *TrainingLoad) Reset() {
+	*tl = TrainingLoad{}
+}
+
+// Overtrained reports whether the run has gone past the point of
+// diminishing returns
+func (tl TrainingLoad) Overtrained() bool {
+	return tl.Sessions > overtrainingThreshold
+}
+
+// overtrainingFactor scales improvement chance for a run of sessions
+func overtrainingFactor(sessions int) float64 {
+	extra := sessions - overtrainingThreshold
+	if extra <= 0 {
+		return 1.0
+	}
+	return 1 / (1 + overtrainingDecay*float64(extra))
+}
+
+// overtrainingMoraleLoss is the morale cost of a run of sessions
+func overtrainingMoraleLoss(sessions int) float64 {
+	return math.Max(0, float64(sessions-overtrainingThreshold)) * overtrainingMorale
+}
@@ -0,0 +1,98 @@
+// domain/player/overtraining_test.go
+package player
+
+import (
+	"math"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+func TestOvertrainingFactor(t *testing.T) {
+	tests := []struct {
+		sessions int
+		want     float64
+	}{
+		{1, 1},
+		{overtrainingThreshold, 1},
+		{overtrainingThreshold + 1, 0.8},
+		{overtrainingThreshold + 2, 1 / 1.5},
+		{overtrainingThreshold + 4, 0.5},
+	}
+
+	for _, tt := range tests {
+		if got := overtrainingFactor(tt.sessions); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("overtrainingFactor(%d) = %.3f, want %.3f", tt.sessions, got, tt.want)
+		}
+	}
+}
+
+func TestTrainingLoadRunsOfOneType(t *testing.T) {
+	p := newTestPlayer("p", PositionMID, 20)
+	dm := NewDevelopmentManagerWithSource(common.NewRandSource(1))
+
+	sessions := []struct {
+		trainingType    TrainingType
+		wantRun         int
+		wantOvertrained bool
+		wantMorale      float64
+	}{
+		{TrainingPhysical, 1, false, 0},
+		{TrainingPhysical, 2, false, 0},
+		{TrainingPhysical, 3, false, 0},
+		{TrainingPhysical, 4, true, -1},
+		{TrainingPhysical, 5, true, -2},
+		{TrainingTactical, 1, false, 0}, // Switching type starts a new run
+		{TrainingPhysical, 1, false, 0},
+	}
+
+	for i, s := range sessions {
+		result := dm.ProcessTraining(p, s.trainingType, 0.8)
+		if p.TrainingLoad.Sessions != s.wantRun || p.TrainingLoad.Type != s.trainingType {
+			t.Errorf("session %d: load = %+v, want a run of %d %s", i+1, p.TrainingLoad, s.wantRun, s.trainingType)
+		}
+		if result.Overtrained != s.wantOvertrained {
+			t.Errorf("session %d: Overtrained = %v, want %v", i+1, result.Overtrained, s.wantOvertrained)
+		}
+		if result.MoraleChange != s.wantMorale {
+			t.Errorf("session %d: MoraleChange = %.1f, want %.1f", i+1, result.MoraleChange, s.wantMorale)
+		}
+	}
+
+	p.TrainingLoad.Reset()
+	if p.TrainingLoad != (TrainingLoad{}) || p.TrainingLoad.Overtrained() {
+		t.Errorf("load after Reset = %+v, want empty", p.TrainingLoad)
+	}
+}
+
+func TestRepeatedSessionsImproveLess(t *testing.T) {
+	// improvementRate trains fresh players who have already done run-1
+	// identical sessions and returns how often the next one improves them
+	improvementRate := func(run int) float64 {
+		dm := NewDevelopmentManagerWithSource(common.NewRandSource(12))
+		const trials = 3000
+		improved := 0
+		for i := 0; i < trials; i++ {
+			p := newTestPlayer("p", PositionMID, 19)
+			p.TrainingLoad = TrainingLoad{Type: TrainingPhysical, Sessions: run - 1}
+			if len(dm.ProcessTraining(p, TrainingPhysical, 0.5).AttributeChanges) > 0 {
+				improved++
+			}
+		}
+		return float64(improved) / trials
+	}
+
+	fresh := improvementRate(1)
+	atThreshold := improvementRate(overtrainingThreshold)
+	overtrained := improvementRate(overtrainingThreshold + 5)
+
+	if fresh == 0 {
+		t.Fatal("fresh sessions never improved anyone; the comparison says nothing")
+	}
+	if math.Abs(atThreshold-fresh) > 0.05 {
+		t.Errorf("improvement rate %.3f at the threshold, %.3f fresh; want no drop yet", atThreshold, fresh)
+	}
+	if overtrained > fresh*0.7 {
+		t.Errorf("improvement rate %.3f after a long run, %.3f fresh; want a clear drop", overtrained, fresh)
+	}
+}
@@ -0,0 +1,58 @@
+// domain/player/performance.go
+package player
+
+import (
+	"math"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+// Performance modifier bounds and neutral levels
+const (
+	neutralMorale        = 75.0
+	neutralForm          = 70.0
+	fatigueFitness       = 70.0 // Below this, tiredness starts to tell
+	psychologicalWeight  = 0.2
+	minPerformanceFactor = 0.7
+	maxPerformanceFactor = 1.15
+
+	minRatingSpread = 0.1 // Rating standard deviation of the most consistent player
+	maxRatingSpread = 0.9 // Rating standard deviation of the least consistent player
+	bigMatchSwing   = 0.8 // Rating swing between the worst and best big-game players
+)
+
+// GetMatchPerformanceModifier returns a multiplier for the player's
+// effective rating on the pitch. Morale and form above their usual levels
+// (75 and 70) lift performance and below them drag it down, while fitness
+// only counts once it drops under 70. The result is clamped to 0.7-1.15,
+// with 1.0 for a fit player in ordinary spirits.
+func (p *Player) GetMatchPerformanceModifier() float64 {
+	modifier := 1.0
+	modifier += (p.Morale - neutralMorale) / 100 * psychologicalWeight
+	modifier += (p.Form - neutralForm) / 100 * psychologicalWeight
+	if p.Fitness < fatigueFitness {
+		modifier -= (fatigueFitness - p.Fitness) / 140
+	}
+
+	return math.Max(minPerformanceFactor, math.Min(modifier, maxPerformanceFactor))
+}
+
+// SampleMatchRating draws a 1-10 match rating around a base rating. Less
+// consistent players vary more from match to match, and in big matches
+// players who relish the occasion gain while those who freeze lose out.
+func (p *Player) SampleMatchRating(base float64, bigMatch bool, seed int64) float64 {
+	return p.SampleMatchRatingWithSource(base, bigMatch, common.NewRandSource(seed))
+}
+
+// SampleMatchRatingWithSource draws a match rating like SampleMatchRating,
+// drawing from the given source
+func (p *Player) SampleMatchRatingWithSource(base float64, bigMatch bool, rng common.RandSource) float64 {
+	spread := minRatingSpread + (maxRatingSpread-minRatingSpread)*float64(100-p.Attributes.Consistency)/100
+	rating := base + common.NormFloat64(rng)*spread
+
+	if bigMatch {
+		rating += (float64(p.Attributes.ImportantMatches) - 50) / 100 * bigMatchSwing
+	}
+
+	return math.Max(1, math.Min(rating, 10))
+}
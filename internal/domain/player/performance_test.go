@@ -0,0 +1,114 @@
+// domain/player/performance_test.go
+package player
+
+import (
+	"math"
+	"testing"
+)
+
+// ratingSpread samples match ratings and returns their mean and standard
+// deviation
+func ratingSpread(p *Player, base float64, bigMatch bool) (float64, float64) {
+	const n = 5000
+	var sum, sumSq float64
+	for i := 0; i < n; i++ {
+		r := p.SampleMatchRating(base, bigMatch, int64(i))
+		sum += r
+		sumSq += r * r
+	}
+	mean := sum / n
+	return mean, math.Sqrt(sumSq/n - mean*mean)
+}
+
+func TestSampleMatchRatingVarianceByConsistency(t *testing.T) {
+	tests := []struct {
+		name        string
+		consistency int
+		wantStdDev  float64
+	}{
+		{"most consistent", 100, minRatingSpread},
+		{"average", 50, (minRatingSpread + maxRatingSpread) / 2},
+		{"least consistent", 0, maxRatingSpread},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, 26)
+			p.Attributes.Consistency = tt.consistency
+
+			mean, stdDev := ratingSpread(p, 6.5, false)
+			if math.Abs(mean-6.5) > 0.05 {
+				t.Errorf("mean rating = %.3f, want about 6.5", mean)
+			}
+			if math.Abs(stdDev-tt.wantStdDev) > 0.05 {
+				t.Errorf("rating standard deviation = %.3f, want about %.2f", stdDev, tt.wantStdDev)
+			}
+		})
+	}
+}
+
+func TestSampleMatchRatingBigMatch(t *testing.T) {
+	tests := []struct {
+		name             string
+		importantMatches int
+		wantShift        float64
+	}{
+		{"relishes the occasion", 100, bigMatchSwing / 2},
+		{"indifferent", 50, 0},
+		{"freezes", 0, -bigMatchSwing / 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, 26)
+			p.Attributes.Consistency = 100
+			p.Attributes.ImportantMatches = tt.importantMatches
+
+			normal, _ := ratingSpread(p, 6.5, false)
+			big, _ := ratingSpread(p, 6.5, true)
+			if shift := big - normal; math.Abs(shift-tt.wantShift) > 0.01 {
+				t.Errorf("big-match shift = %.3f, want %.2f", shift, tt.wantShift)
+			}
+		})
+	}
+}
+
+func TestSampleMatchRatingBounds(t *testing.T) {
+	p := newTestPlayer("p", PositionMID, 26)
+	p.Attributes.Consistency = 0
+	p.Attributes.ImportantMatches = 100
+
+	for _, base := range []float64{0.5, 1, 9.8, 12} {
+		for i := 0; i < 500; i++ {
+			if r := p.SampleMatchRating(base, true, int64(i)); r < 1 || r > 10 {
+				t.Fatalf("SampleMatchRating(%.1f) = %.2f, want within 1-10", base, r)
+			}
+		}
+	}
+}
+
+func TestGetMatchPerformanceModifier(t *testing.T) {
+	tests := []struct {
+		name                  string
+		morale, form, fitness float64
+		want                  float64
+	}{
+		{"fit and in ordinary spirits", 75, 70, 100, 1.0},
+		{"fitness down to the threshold", 75, 70, 70, 1.0},
+		{"tired", 75, 70, 56, 0.9},
+		{"low morale", 50, 70, 100, 0.95},
+		{"in form", 75, 95, 100, 1.05},
+		{"flying", 100, 100, 100, 1.11},
+		{"spent and miserable", 0, 0, 0, minPerformanceFactor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, 25)
+			p.Morale, p.Form, p.Fitness = tt.morale, tt.form, tt.fitness
+			if got := p.GetMatchPerformanceModifier(); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("GetMatchPerformanceModifier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
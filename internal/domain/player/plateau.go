@@ -0,0 +1,67 @@
+// domain/player/plateau.go
+package player
+
+// PlateauReason explains why training an attribute is wasted
+type PlateauReason string
+
+const (
+	PlateauAtCeiling   PlateauReason = "at_ceiling"   // Reached the player's potential
+	PlateauNearCeiling PlateauReason = "near_ceiling" // Within a few points of it
+	PlateauPastPeakAge PlateauReason = "past_peak_age"
+)
+
+// nearCeilingMargin matches the slow-progress band in calculateImprovement
+const nearCeilingMargin = 5
+
+// AttributePlateau reports an attribute that training can barely move
+type AttributePlateau struct {
+	Attribute string
+	Value     int
+	Ceiling   int
+	Reason    PlateauReason
+}
+
+// trainingFocus lists the attributes a training type works on
+func trainingFocus(player *Player, trainingType TrainingType) []string {
+	switch trainingType {
+	case TrainingTechnical:
+		return []string{"Passing", "BallControl", "Shooting"}
+	case TrainingPhysical:
+		return []string{"Speed", "Stamina", "Heading"}
+	case TrainingTactical:
+		return []string{"Perception", "Tackling"}
+	case TrainingSetPieces:
+		if player.Position == PositionGK {
+			return []string{"Keeping"}
+		}
+		return []string{"Heading", "Shooting"}
+	default:
+		return AttributeNames()
+	}
+}
+
+// findPlateaus checks the trained attributes against the improvement
+// ceiling and the player's age
+func (dm *DevelopmentManager) findPlateaus(player *Player, attributes []string) []AttributePlateau {
+	ceiling := dm.improvementCeiling(player)
+	age := player.Age()
+
+	plateaus := []AttributePlateau{}
+	for _, name := range attributes {
+		value := dm.getAttributeValue(player, name)
+		plateau := AttributePlateau{Attribute: name, Value: value, Ceiling: ceiling}
+
+		switch headroom := ceiling - value; {
+		case headroom <= 0:
+			plateau.Reason = PlateauAtCeiling
+		case headroom <= nearCeilingMargin:
+			plateau.Reason = PlateauNearCeiling
+		case !player.Attributes.CanImprove(name, age):
+			plateau.Reason = PlateauPastPeakAge
+		default:
+			continue
+		}
+		plateaus = append(plateaus, plateau)
+	}
+	return plateaus
+}
@@ -29,6 +29,9 @@ const (
 	StatusRetired   Status = "retired"
 )
 
+// MinAvailableFitness is the fitness IsAvailable requires
+const MinAvailableFitness = 70.0
+
 // Player represents a football player
 type Player struct {
 	ID          PlayerID
@@ -44,17 +47,25 @@ type Player struct {
 
 	// Playing information
 	Position      Position
-	PreferredFoot string // "left", "right", "both"
+	Retraining    *PositionRetraining // nil unless converting to a new position
+	PreferredFoot string              // "left", "right", "both"
 	ShirtNumber   int
 	ContractUntil time.Time
 	MarketValue   int64 // in currency units
 	Wage          int64 // weekly wage
 
 	// Current state
-	Status  Status
-	Fitness float64 // 0-100
-	Morale  float64 // 0-100
-	Form    float64 // 0-100
+	Status          Status
+	SuspensionGames int     // Matches left to serve while suspended
+	CurrentInjury   *Injury // nil unless injured
+	InjuryHistory   []Injury
+	Fitness         float64      // 0-100
+	Morale          float64      // 0-100
+	Form            float64      // 0-100
+	TrainingLoad    TrainingLoad // Recent run of identical training sessions
+
+	// TransferRequested is set when the player has asked to leave
+	TransferRequested bool
 
 	// Attributes
 	Attributes Attributes
@@ -64,6 +75,10 @@ type Player struct {
 
 	// Team affiliation
 	CurrentTeamID string
+	Loan          *LoanDeal // nil unless out on loan
+
+	// ratingCache holds the last computed overall rating
+	ratingCache ratingCache
 
 	// Metadata
 	CreatedAt time.Time
@@ -77,8 +92,14 @@ type CareerStats struct {
 	TotalAssists     int
 	TotalYellowCards int
 	TotalRedCards    int
-	TotalCleanSheets int // for goalkeepers
+	TotalCleanSheets int // for keepers and defenders
+	TotalMinutes     int
+	GoalTypes        GoalBreakdown
+	AverageRating    float64
 	SeasonStats      []SeasonStats
+
+	// CurrentSeason accumulates stats until the season is finalized
+	CurrentSeason SeasonStats
 }
 
 // SeasonStats tracks stats for a specific season
@@ -90,13 +111,16 @@ type SeasonStats struct {
 	Assists       int
 	YellowCards   int
 	RedCards      int
+	SecondYellows int // Red cards shown for a second booking
 	CleanSheets   int
+	Minutes       int
 	AverageRating float64
 }
 
-// NewPlayer creates a new player
+// NewPlayer creates a new player with the default attributes for their
+// position
 func NewPlayer(id PlayerID, firstName, lastName string, position Position, dateOfBirth time.Time) *Player {
-	return &Player{
+	p := &Player{
 		ID:          id,
 		FirstName:   firstName,
 		LastName:    lastName,
@@ -110,18 +134,67 @@ func NewPlayer(id PlayerID, firstName, lastName string, position Position, dateO
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
+	p.refreshRating()
+	return p
+}
+
+// NewPlayerWithAttributes creates a player from known attributes, such as
+// seed data, rejecting any outside the 0-100 scale
+func NewPlayerWithAttributes(id PlayerID, firstName, lastName string, position Position, dateOfBirth time.Time, attrs Attributes) (*Player, error) {
+	if err := attrs.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := NewPlayer(id, firstName, lastName, position, dateOfBirth)
+	p.Attributes = attrs
+	p.refreshRating()
+	return p, nil
 }
 
 // Age calculates the player's current age
 func (p *Player) Age() int {
 	now := time.Now()
 	years := now.Year() - p.DateOfBirth.Year()
-	if now.YearDay() < p.DateOfBirth.YearDay() {
+	// Compare calendar dates, as day-of-year shifts by one in leap years
+	if now.Month() < p.DateOfBirth.Month() ||
+		(now.Month() == p.DateOfBirth.Month() && now.Day() < p.DateOfBirth.Day()) {
 		years--
 	}
 	return years
 }
 
+// IsEligibleForAgeGroup reports whether the player is young enough for an
+// age-restricted competition, where maxAge is the oldest age allowed
+func (p *Player) IsEligibleForAgeGroup(maxAge int) bool {
+	return p.Age() <= maxAge
+}
+
+// Clone returns a deep copy of the player that shares no slices or
+// pointers with the original
+func (p *Player) Clone() Player {
+	clone := *p
+	clone.CareerStats.SeasonStats = append([]SeasonStats(nil), p.CareerStats.SeasonStats...)
+	if p.Loan != nil {
+		loan := *p.Loan
+		clone.Loan = &loan
+	}
+	if p.Retraining != nil {
+		retraining := *p.Retraining
+		clone.Retraining = &retraining
+	}
+	if p.CurrentInjury != nil {
+		injury := p.CurrentInjury.clone()
+		clone.CurrentInjury = &injury
+	}
+	if p.InjuryHistory != nil {
+		clone.InjuryHistory = make([]Injury, len(p.InjuryHistory))
+		for i, injury := range p.InjuryHistory {
+			clone.InjuryHistory[i] = injury.clone()
+		}
+	}
+	return clone
+}
+
 // FullName returns the player's full name
 func (p *Player) FullName() string {
 	if p.Nickname != "" {
@@ -132,7 +205,13 @@ func (p *Player) FullName() string {
 
 // IsAvailable checks if player can play
 func (p *Player) IsAvailable() bool {
-	return p.Status == StatusAvailable && p.Fitness >= 70
+	return p.IsSelectable(MinAvailableFitness)
+}
+
+// IsSelectable checks if player can play at or above a chosen fitness, for
+// managers willing to risk a tired player
+func (p *Player) IsSelectable(minFitness float64) bool {
+	return p.Status == StatusAvailable && p.Fitness >= minFitness
 }
 
 // CanPlayPosition checks if player can play in a given position
@@ -155,9 +234,32 @@ func (p *Player) CanPlayPosition(pos Position) bool {
 	}
 }
 
-// GetOverallRating calculates overall rating based on position
+// GetOverallRating calculates overall rating based on position, rounded to
+// the nearest whole point
 func (p *Player) GetOverallRating() int {
 	switch p.Position {
+	case PositionGK, PositionDEF, PositionMID, PositionFWD:
+		return roundRating(p.overallScore())
+	default:
+		return p.Attributes.Quality
+	}
+}
+
+// GetOverallRatingFloat is the unrounded overall rating, for comparisons
+// finer than whole rating points
+func (p *Player) GetOverallRatingFloat() float64 {
+	switch p.Position {
+	case PositionGK, PositionDEF, PositionMID, PositionFWD:
+		return p.overallScore()
+	default:
+		return float64(p.Attributes.Quality)
+	}
+}
+
+// GetRatingAtPosition rates the player when deployed in a given position,
+// which may differ from their natural one
+func (p *Player) GetRatingAtPosition(pos Position) int {
+	switch pos {
 	case PositionGK:
 		return p.Attributes.GetGoalkeeperRating()
 	case PositionDEF:
@@ -167,20 +269,152 @@ func (p *Player) GetOverallRating() int {
 	case PositionFWD:
 		return p.Attributes.GetForwardRating()
 	default:
-		return p.Attributes.Quality
+		return p.GetOverallRating()
+	}
+}
+
+// PositionRatings rates the player in every position they can play, to
+// show where a versatile player is best deployed
+func (p *Player) PositionRatings() map[Position]int {
+	ratings := make(map[Position]int)
+	for _, pos := range []Position{PositionGK, PositionDEF, PositionMID, PositionFWD} {
+		if p.CanPlayPosition(pos) {
+			ratings[pos] = p.GetRatingAtPosition(pos)
+		}
+	}
+	return ratings
+}
+
+// MatchUpdate reports consequences of recording a match
+type MatchUpdate struct {
+	Milestones []Milestone
+	Suspension *Suspension // nil when no ban was triggered
+}
+
+// UpdateMatchStats updates player statistics after a match using the
+// default suspension rules
+func (p *Player) UpdateMatchStats(goals, assists, yellowCards, redCards int, rating float64) (MatchUpdate, error) {
+	return p.UpdateMatchStatsWithRules(goals, assists, yellowCards, redCards, rating, DefaultSuspensionRules())
+}
+
+// UpdateMatchStatsWithRules updates player statistics after a match and
+// reports milestones reached and any suspension triggered. A bare goal
+// count carries no type, so the goals are counted as open play.
+func (p *Player) UpdateMatchStatsWithRules(goals, assists, yellowCards, redCards int, rating float64, rules SuspensionRules) (MatchUpdate, error) {
+	goalTypes := make([]GoalType, goals)
+	for i := range goalTypes {
+		goalTypes[i] = GoalOpenPlay
 	}
+	cards := MatchCards{Yellow: yellowCards, Red: redCards}
+	return p.UpdateMatchStatsWithGoalsAndRules(goalTypes, assists, cards, rating, rules)
 }
 
-// UpdateMatchStats updates player statistics after a match
-func (p *Player) UpdateMatchStats(goals, assists, yellowCards, redCards int, rating float64) {
+// UpdateMatchStatsWithGoals is UpdateMatchStats for goals of known types,
+// one entry per goal, and bookings that tell a second yellow from a
+// straight red
+func (p *Player) UpdateMatchStatsWithGoals(goals []GoalType, assists int, cards MatchCards, rating float64) (MatchUpdate, error) {
+	return p.UpdateMatchStatsWithGoalsAndRules(goals, assists, cards, rating, DefaultSuspensionRules())
+}
+
+// UpdateMatchStatsWithGoalsAndRules updates player statistics after a
+// match, crediting each goal by type as RecordTypedGoal does. Goals of an
+// unknown type count as open play.
+func (p *Player) UpdateMatchStatsWithGoalsAndRules(goals []GoalType, assists int, cards MatchCards, rating float64, rules SuspensionRules) (MatchUpdate, error) {
+	before := p.CareerStats
+
 	p.CareerStats.TotalMatches++
-	p.CareerStats.TotalGoals += goals
+	for _, goalType := range goals {
+		p.creditGoal(goalType)
+	}
 	p.CareerStats.TotalAssists += assists
-	p.CareerStats.TotalYellowCards += yellowCards
-	p.CareerStats.TotalRedCards += redCards
+	p.CareerStats.TotalYellowCards += cards.Yellow
+	p.CareerStats.TotalRedCards += cards.Red
+	p.CareerStats.AverageRating += (rating - p.CareerStats.AverageRating) / float64(p.CareerStats.TotalMatches)
+
+	season := &p.CareerStats.CurrentSeason
+	season.Matches++
+	season.Assists += assists
+	season.YellowCards += cards.Yellow
+	season.RedCards += cards.Red
+	season.SecondYellows += cards.SecondYellow
+	season.AverageRating += (rating - season.AverageRating) / float64(season.Matches)
 
 	// Update form based on performance
 	p.updateForm(rating)
+
+	suspension, err := p.applySuspension(before.CurrentSeason, cards, rules)
+	return MatchUpdate{
+		Milestones: newMilestones(before, p.CareerStats),
+		Suspension: suspension,
+	}, err
+}
+
+// RecordMinutes adds time on the pitch to the player's stats
+func (p *Player) RecordMinutes(minutes int) {
+	if minutes <= 0 {
+		return
+	}
+	p.CareerStats.TotalMinutes += minutes
+	p.CareerStats.CurrentSeason.Minutes += minutes
+}
+
+// GetMinutesPerGoal returns the career minutes played for each goal scored,
+// or 0 before the first goal
+func (p *Player) GetMinutesPerGoal() float64 {
+	if p.CareerStats.TotalGoals == 0 {
+		return 0
+	}
+	return float64(p.CareerStats.TotalMinutes) / float64(p.CareerStats.TotalGoals)
+}
+
+// GetMinutesPerMatch returns the average career minutes per appearance
+func (p *Player) GetMinutesPerMatch() float64 {
+	if p.CareerStats.TotalMatches == 0 {
+		return 0
+	}
+	return float64(p.CareerStats.TotalMinutes) / float64(p.CareerStats.TotalMatches)
+}
+
+// RecordCleanSheet credits the player with a clean sheet
+func (p *Player) RecordCleanSheet() {
+	p.CareerStats.TotalCleanSheets++
+	p.CareerStats.CurrentSeason.CleanSheets++
+}
+
+// GetCareerAverageRating returns the average match rating across the career
+func (p *Player) GetCareerAverageRating() float64 {
+	if p.CareerStats.TotalMatches == 0 {
+		return 0
+	}
+	return p.CareerStats.AverageRating
+}
+
+// GetSeasonAverageRating returns the average match rating this season
+func (p *Player) GetSeasonAverageRating() float64 {
+	if p.CareerStats.CurrentSeason.Matches == 0 {
+		return 0
+	}
+	return p.CareerStats.CurrentSeason.AverageRating
+}
+
+// FinalizeSeason archives the current season's stats and starts a new season.
+// It returns false if the season was already finalized for the team.
+func (p *Player) FinalizeSeason(seasonID, teamID string) bool {
+	for _, s := range p.CareerStats.SeasonStats {
+		if s.SeasonID == seasonID && s.TeamID == teamID {
+			return false
+		}
+	}
+
+	season := p.CareerStats.CurrentSeason
+	season.SeasonID = seasonID
+	season.TeamID = teamID
+
+	p.CareerStats.SeasonStats = append(p.CareerStats.SeasonStats, season)
+	p.CareerStats.CurrentSeason = SeasonStats{}
+	p.UpdatedAt = time.Now()
+
+	return true
 }
 
 // updateForm adjusts player form based on recent performance
@@ -0,0 +1,219 @@
+// domain/player/player_test.go
+package player
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCloneIsIndependent(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(p *Player)
+	}{
+		{"season stats", func(p *Player) { p.CareerStats.SeasonStats[0].Goals = 99 }},
+		{"appended season", func(p *Player) {
+			p.CareerStats.SeasonStats = append(p.CareerStats.SeasonStats[:1], SeasonStats{SeasonID: "extra"})
+		}},
+		{"current injury", func(p *Player) { p.CurrentInjury.AttributeLoss["pace"] = 10 }},
+		{"injury history", func(p *Player) { p.InjuryHistory[0].AttributeLoss["pace"] = 10 }},
+		{"loan", func(p *Player) { p.Loan.WageShare = 1 }},
+		{"retraining", func(p *Player) { p.Retraining.Target = PositionGK }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := newTestPlayer("p", PositionMID, 26)
+			original.CareerStats.SeasonStats = make([]SeasonStats, 2, 4) // spare capacity exposes shared backing arrays
+			original.CareerStats.SeasonStats[0] = SeasonStats{SeasonID: "2024", Goals: 5}
+			original.CareerStats.SeasonStats[1] = SeasonStats{SeasonID: "2025", Goals: 8}
+			original.CurrentInjury = &Injury{Type: "hamstring", Days: 10, AttributeLoss: map[string]int{"pace": 1}}
+			original.InjuryHistory = []Injury{{Type: "ankle", Days: 5, AttributeLoss: map[string]int{"pace": 1}}}
+			original.Loan = &LoanDeal{ParentTeamID: "parent", WageShare: 0.5, StartDate: time.Now()}
+			original.Retraining = &PositionRetraining{Target: PositionDEF}
+
+			want := original.Clone()
+			clone := original.Clone()
+			tt.mutate(&clone)
+
+			if !reflect.DeepEqual(*original, want) {
+				t.Errorf("changing the clone's %s changed the original", tt.name)
+			}
+		})
+	}
+}
+
+func TestRoundRating(t *testing.T) {
+	tests := []struct {
+		score float64
+		want  int
+	}{
+		{74.0, 74},
+		{74.4, 74},
+		{74.49, 74},
+		{74.5, 75},
+		{74.9, 75},
+		{0.5, 1},
+		{0.49, 0},
+		{0.1 + 0.2 + 74.2, 75}, // Float error just under the half
+	}
+
+	for _, tt := range tests {
+		if got := roundRating(tt.score); got != tt.want {
+			t.Errorf("roundRating(%v) = %d, want %d", tt.score, got, tt.want)
+		}
+	}
+}
+
+func TestOverallRatingRoundsToNearest(t *testing.T) {
+	tests := []struct {
+		name      string
+		keeping   int // Weighted 0.5 for keepers
+		speed     int // Weighted 0.1 for keepers
+		wantFloat float64
+		want      int
+	}{
+		{"whole", 74, 74, 74, 74},
+		{"just under the half", 74, 78, 74.4, 74},
+		{"exactly half", 75, 74, 74.5, 75},
+		{"over the half", 75, 78, 74.9, 75},
+		{"a point and a half", 77, 74, 75.5, 76},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionGK, 26)
+			for _, name := range AttributeNames() {
+				if err := p.Attributes.Set(name, 74); err != nil {
+					t.Fatal(err)
+				}
+			}
+			p.Attributes.Keeping, p.Attributes.Speed = tt.keeping, tt.speed
+
+			if got := p.GetOverallRatingFloat(); math.Abs(got-tt.wantFloat) > 1e-9 {
+				t.Errorf("GetOverallRatingFloat() = %v, want %v", got, tt.wantFloat)
+			}
+			if got := p.GetOverallRating(); got != tt.want {
+				t.Errorf("GetOverallRating() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPositionRatings(t *testing.T) {
+	tests := []struct {
+		name    string
+		pos     Position
+		passing int
+		want    []Position
+	}{
+		{"goalkeeper only in goal", PositionGK, 90, []Position{PositionGK}},
+		{"midfielder covers defence and attack", PositionMID, 70, []Position{PositionDEF, PositionMID, PositionFWD}},
+		{"limited forward", PositionFWD, 60, []Position{PositionFWD}},
+		{"ball-playing forward drops deeper", PositionFWD, 61, []Position{PositionMID, PositionFWD}},
+		{"limited defender", PositionDEF, 55, []Position{PositionDEF}},
+		{"ball-playing defender steps up", PositionDEF, 75, []Position{PositionDEF, PositionMID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", tt.pos, 26)
+			p.Attributes.Passing = tt.passing
+
+			ratings := p.PositionRatings()
+			if len(ratings) != len(tt.want) {
+				t.Errorf("PositionRatings() = %v, want entries for %v", ratings, tt.want)
+			}
+			for _, pos := range tt.want {
+				got, ok := ratings[pos]
+				if !ok {
+					t.Errorf("no rating at %s", pos)
+					continue
+				}
+				if want := p.GetRatingAtPosition(pos); got != want {
+					t.Errorf("rating at %s = %d, want %d", pos, got, want)
+				}
+			}
+			if got := ratings[tt.pos]; got != p.GetOverallRating() {
+				t.Errorf("rating in natural position = %d, want the overall rating %d", got, p.GetOverallRating())
+			}
+		})
+	}
+}
+
+func TestPositionRatingsShowBestDeployment(t *testing.T) {
+	// A winger with a defender's engine rates better at full back
+	p := newTestPlayer("p", PositionMID, 26)
+	p.Attributes.Tackling, p.Attributes.Heading, p.Attributes.Speed = 85, 80, 85
+	p.Attributes.BallControl, p.Attributes.Shooting = 50, 40
+
+	ratings := p.PositionRatings()
+	if ratings[PositionDEF] <= ratings[PositionMID] {
+		t.Errorf("DEF rating %d, MID rating %d; want the defensive winger rated higher at the back",
+			ratings[PositionDEF], ratings[PositionMID])
+	}
+}
+
+func TestAgeAcrossLeapYears(t *testing.T) {
+	// Born in a leap year, so day-of-year runs a day ahead of this year's
+	// from March onwards
+	now := time.Now()
+	years := 20
+	for y := now.Year() - years; y%4 != 0 || (y%100 == 0 && y%400 != 0); y-- {
+		years++
+	}
+
+	tests := []struct {
+		name string
+		days int // Offset of the birthday from today
+		want int
+	}{
+		{"birthday today", 0, years},
+		{"birthday yesterday", -1, years},
+		{"birthday tomorrow", 1, years - 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, 0)
+			p.DateOfBirth = now.AddDate(-years, 0, tt.days)
+			if got := p.Age(); got != tt.want {
+				t.Errorf("Age() = %d for a birthday on %s, want %d", got, p.DateOfBirth.Format("2006-01-02"), tt.want)
+			}
+		})
+	}
+}
+
+func TestAverageRatings(t *testing.T) {
+	p := newTestPlayer("p", PositionMID, 25)
+	if got := p.GetCareerAverageRating(); got != 0 {
+		t.Errorf("career average before any matches = %v, want 0", got)
+	}
+	if got := p.GetSeasonAverageRating(); got != 0 {
+		t.Errorf("season average before any matches = %v, want 0", got)
+	}
+
+	// Two matches last season and one this season weigh by match, not by
+	// season
+	for _, rating := range []float64{6, 6} {
+		if _, err := p.UpdateMatchStats(0, 0, 0, 0, rating); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p.FinalizeSeason("2025", "club")
+	if got := p.GetSeasonAverageRating(); got != 0 {
+		t.Errorf("season average after the season ended = %v, want 0", got)
+	}
+	if _, err := p.UpdateMatchStats(0, 0, 0, 0, 9); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := p.GetCareerAverageRating(); math.Abs(got-7) > 1e-9 {
+		t.Errorf("career average = %v, want 7", got)
+	}
+	if got := p.GetSeasonAverageRating(); got != 9 {
+		t.Errorf("season average = %v, want 9", got)
+	}
+}
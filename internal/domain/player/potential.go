@@ -0,0 +1,65 @@
+// domain/player/potential.go
+package player
+
+import (
+	"math"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+// SetPotentialRange sets the hidden potential bounds and derives Potential
+// as their midpoint. The growth ceiling is drawn afresh from the new range.
+func (a *Attributes) SetPotentialRange(min, max int) {
+	if min > max {
+		min, max = max, min
+	}
+	min = clampAttribute(min)
+	max = clampAttribute(max)
+
+	a.PotentialRange = [2]int{min, max}
+	a.Potential = (min + max) / 2
+	a.PotentialCap = 0
+}
+
+// PotentialBounds returns the hidden potential range, falling back to
+// Potential when no valid range has been set
+func (a *Attributes) PotentialBounds() (int, int) {
+	if a.PotentialRange == [2]int{} || a.PotentialRange[1] < a.PotentialRange[0] {
+		return a.Potential, a.Potential
+	}
+	return a.PotentialRange[0], a.PotentialRange[1]
+}
+
+// PotentialEstimate returns the potential range as scouts see it: wide for
+// young, inexperienced players and narrowing to the true range over time
+func (p *Player) PotentialEstimate() (int, int) {
+	min, max := p.Attributes.PotentialBounds()
+
+	uncertainty := 0.0
+	if age := p.Age(); age < 24 {
+		uncertainty += float64(24-age) * 1.5
+	}
+	if matches := p.CareerStats.TotalMatches; matches < 100 {
+		uncertainty += float64(100-matches) / 20
+	}
+
+	spread := int(math.Round(uncertainty))
+	return clampAttribute(min - spread), clampAttribute(max + spread)
+}
+
+// samplePotential returns the player's growth ceiling, drawing it from
+// within the hidden range the first time it is needed and again only if
+// the range no longer contains it, so every check compares against the
+// same ceiling
+func samplePotential(rng common.RandSource, a *Attributes) int {
+	min, max := a.PotentialBounds()
+	if a.PotentialCap == 0 || a.PotentialCap < min || a.PotentialCap > max {
+		a.PotentialCap = min + rng.Intn(max-min+1)
+	}
+	return a.PotentialCap
+}
+
+// clampAttribute bounds a value to the 0-100 attribute scale
+func clampAttribute(v int) int {
+	return int(math.Max(0, math.Min(float64(v), 100)))
+}
@@ -0,0 +1,81 @@
+// domain/player/potential_test.go
+package player
+
+import "testing"
+
+func TestPotentialEstimate(t *testing.T) {
+	tests := []struct {
+		name      string
+		age       int
+		matches   int
+		min, max  int // Hidden range, unset when both are zero
+		potential int
+		wantMin   int
+		wantMax   int
+	}{
+		{"established player sees the true range", 27, 150, 70, 80, 0, 70, 80},
+		{"no range falls back to potential", 27, 150, 0, 0, 75, 75, 75},
+		// 1.5 per year under 24 plus 1 per 20 matches short of 100
+		{"teenager without matches", 18, 0, 70, 80, 0, 56, 94},
+		{"young regular", 21, 100, 70, 80, 0, 65, 85},
+		{"late starter", 26, 40, 70, 80, 0, 67, 83},
+		{"clamped to the scale", 16, 0, 5, 95, 0, 0, 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, tt.age)
+			p.CareerStats.TotalMatches = tt.matches
+			if tt.min != 0 || tt.max != 0 {
+				p.Attributes.SetPotentialRange(tt.min, tt.max)
+			} else {
+				p.Attributes.PotentialRange = [2]int{}
+				p.Attributes.Potential = tt.potential
+			}
+
+			min, max := p.PotentialEstimate()
+			if min != tt.wantMin || max != tt.wantMax {
+				t.Errorf("PotentialEstimate() = %d-%d, want %d-%d", min, max, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestPotentialEstimateNarrowsWithExperience(t *testing.T) {
+	p := newTestPlayer("p", PositionMID, 17)
+	p.Attributes.SetPotentialRange(70, 80)
+
+	lastMin, lastMax := p.PotentialEstimate()
+	for season := 0; season < 8; season++ {
+		p.DateOfBirth = p.DateOfBirth.AddDate(-1, 0, 0)
+		p.CareerStats.TotalMatches += 20
+
+		min, max := p.PotentialEstimate()
+		if min < lastMin || max > lastMax {
+			t.Fatalf("season %d: estimate widened from %d-%d to %d-%d", season, lastMin, lastMax, min, max)
+		}
+		if min > 70 || max < 80 {
+			t.Fatalf("season %d: estimate %d-%d excludes the true range", season, min, max)
+		}
+		lastMin, lastMax = min, max
+	}
+	if lastMin != 70 || lastMax != 80 {
+		t.Errorf("estimate after eight seasons = %d-%d, want the true 70-80", lastMin, lastMax)
+	}
+}
+
+func TestSetPotentialRange(t *testing.T) {
+	var a Attributes
+	a.PotentialCap = 90
+	a.SetPotentialRange(85, 65)
+
+	if min, max := a.PotentialBounds(); min != 65 || max != 85 {
+		t.Errorf("PotentialBounds() = %d-%d, want the swapped 65-85", min, max)
+	}
+	if a.Potential != 75 {
+		t.Errorf("Potential = %d, want the midpoint 75", a.Potential)
+	}
+	if a.PotentialCap != 0 {
+		t.Errorf("PotentialCap = %d, want it cleared for a fresh draw", a.PotentialCap)
+	}
+}
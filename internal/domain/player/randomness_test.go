@@ -0,0 +1,125 @@
+// domain/player/randomness_test.go
+package player
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+func TestSeededSourcesAreReproducible(t *testing.T) {
+	p := newTestPlayer("p", PositionMID, 33)
+
+	tests := []struct {
+		name string
+		draw func(rng common.RandSource) interface{}
+	}{
+		{"intake", func(rng common.RandSource) interface{} {
+			return identities(GenerateIntakeWithSource("club", 60, rng)...)
+		}},
+		{"regen", func(rng common.RandSource) interface{} { return identities(GenerateRegenWithSource(p, rng)) }},
+		{"injury", func(rng common.RandSource) interface{} {
+			injury := RollInjuryWithSource(p, rng)
+			return []interface{}{injury.Type, injury.Days, injury.AttributeLoss}
+		}},
+		{"scouting", func(rng common.RandSource) interface{} { return ScoutPlayerWithSource(p, 40, rng) }},
+		{"match rating", func(rng common.RandSource) interface{} { return p.SampleMatchRatingWithSource(6.5, true, rng) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first := tt.draw(common.NewRandSource(42))
+			second := tt.draw(common.NewRandSource(42))
+			if !reflect.DeepEqual(first, second) {
+				t.Errorf("same seed gave %v and %v", first, second)
+			}
+		})
+	}
+}
+
+func TestSeedWrappersMatchSources(t *testing.T) {
+	p := newTestPlayer("p", PositionMID, 25)
+
+	tests := []struct {
+		name       string
+		seeded     interface{}
+		fromSource interface{}
+	}{
+		{"intake", identities(GenerateIntake("club", 60, 42)...), identities(GenerateIntakeWithSource("club", 60, common.NewRandSource(42))...)},
+		{"regen", identities(GenerateRegen(p, 42)), identities(GenerateRegenWithSource(p, common.NewRandSource(42)))},
+		{"injury", RollInjury(p, 42).Days, RollInjuryWithSource(p, common.NewRandSource(42)).Days},
+		{"scouting", ScoutPlayer(p, 40, 42), ScoutPlayerWithSource(p, 40, common.NewRandSource(42))},
+		{"match rating", p.SampleMatchRating(6.5, true, 42), p.SampleMatchRatingWithSource(6.5, true, common.NewRandSource(42))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.seeded, tt.fromSource) {
+				t.Errorf("seed 42 gave %v, source seeded with 42 gave %v", tt.seeded, tt.fromSource)
+			}
+		})
+	}
+}
+
+// identity is the part of a generated player that doesn't depend on the
+// current date
+type identity struct {
+	ID         PlayerID
+	Position   Position
+	Attributes Attributes
+}
+
+// identities reduces generated players to their identities
+func identities(players ...Player) []identity {
+	ids := make([]identity, len(players))
+	for i, p := range players {
+		ids[i] = identity{p.ID, p.Position, p.Attributes}
+	}
+	return ids
+}
+
+func TestConsiderRetirementUsesSource(t *testing.T) {
+	tests := []struct {
+		name string
+		roll float64
+		want bool
+	}{
+		{"low roll retires", 0, true},
+		{"high roll plays on", 0.99, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("veteran", PositionDEF, 36)
+			if p.RetirementChance() <= 0 || p.RetirementChance() >= 0.99 {
+				t.Fatalf("RetirementChance() = %v, want strictly between 0 and 0.99", p.RetirementChance())
+			}
+
+			got := p.ConsiderRetirementWithSource(common.NewScriptedRand(nil, []float64{tt.roll}))
+			if got != tt.want {
+				t.Errorf("ConsiderRetirement() = %v, want %v", got, tt.want)
+			}
+			if retired := p.Status == StatusRetired; retired != tt.want {
+				t.Errorf("Status = %s, retired %v, want %v", p.Status, retired, tt.want)
+			}
+		})
+	}
+}
+
+func TestRollInjuryScripted(t *testing.T) {
+	p := newTestPlayer("p", PositionFWD, 25)
+
+	// The first roll picks the most likely injury type, and a high second
+	// roll leaves no lasting damage
+	injury := RollInjuryWithSource(p, common.NewScriptedRand([]int{0}, []float64{0, 0.99}))
+	if injury.Type != injuryTypes[0] {
+		t.Errorf("Type = %s, want %s", injury.Type, injuryTypes[0])
+	}
+	if want := injuryTypes[0].Profile().MinDays; injury.Days != want {
+		t.Errorf("Days = %d, want %d", injury.Days, want)
+	}
+	if len(injury.AttributeLoss) != 0 {
+		t.Errorf("AttributeLoss = %v, want none", injury.AttributeLoss)
+	}
+}
@@ -0,0 +1,49 @@
+// domain/player/ratingcache.go
+package player
+
+// ratingCache remembers a player's unrounded overall rating along with the
+// attributes, position and weights version it was computed from
+type ratingCache struct {
+	attributes Attributes
+	position   Position
+	weights    uint64
+	score      float64
+	valid      bool
+}
+
+// overallScore returns the player's unrounded rating in their natural
+// position, using the cache only while it matches the current attributes,
+// position and position weights. It never writes the cache, so concurrent
+// readers of a player don't race; only the mutating paths below refresh it.
+func (p *Player) overallScore() float64 {
+	c := &p.ratingCache
+	if c.valid && c.position == p.Position && c.attributes == p.Attributes && c.weights == currentWeightsVersion() {
+		return c.score
+	}
+	return p.Attributes.positionScore(p.Position)
+}
+
+// refreshRating recomputes and caches the unrounded overall rating
+func (p *Player) refreshRating() float64 {
+	score, weights := p.Attributes.versionedPositionScore(p.Position)
+	p.ratingCache = ratingCache{
+		attributes: p.Attributes,
+		position:   p.Position,
+		weights:    weights,
+		score:      score,
+		valid:      true,
+	}
+	return p.ratingCache.score
+}
+
+// InvalidateRating discards the cached overall rating
+func (p *Player) InvalidateRating() {
+	p.ratingCache.valid = false
+}
+
+// RecomputeRating recalculates and caches the overall rating, returning the
+// fresh value. Call it after changing attributes to keep later reads cheap.
+func (p *Player) RecomputeRating() int {
+	p.refreshRating()
+	return p.GetOverallRating()
+}
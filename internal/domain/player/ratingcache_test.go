@@ -0,0 +1,100 @@
+// domain/player/ratingcache_test.go
+package player
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestRatingCacheInvalidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(t *testing.T, p *Player)
+	}{
+		{"attribute edited directly", func(t *testing.T, p *Player) { p.Attributes.Shooting = 95 }},
+		{"position changed", func(t *testing.T, p *Player) { p.Position = PositionDEF }},
+		{"training change", func(t *testing.T, p *Player) {
+			if err := NewDevelopmentManager().applyAttributeChange(p, "Shooting", 25); err != nil {
+				t.Fatal(err)
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionFWD, 24)
+			p.Attributes.Shooting = 50
+			before := p.RecomputeRating()
+
+			tt.change(t, p)
+
+			want := roundRating(p.Attributes.positionScore(p.Position))
+			if got := p.GetOverallRating(); got != want {
+				t.Errorf("GetOverallRating() = %d, want %d", got, want)
+			}
+			if got := p.GetOverallRating(); got == before {
+				t.Errorf("rating still %d after the change", got)
+			}
+		})
+	}
+}
+
+func TestRatingCacheFollowsPositionWeights(t *testing.T) {
+	original := PositionWeights(PositionFWD)
+	t.Cleanup(func() {
+		if err := SetPositionWeights(PositionFWD, original); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	p := newTestPlayer("p", PositionFWD, 24)
+	p.Attributes.Shooting, p.Attributes.Speed = 90, 40
+	before := p.RecomputeRating()
+
+	if err := SetPositionWeights(PositionFWD, map[string]float64{"Speed": 1}); err != nil {
+		t.Fatal(err)
+	}
+	if got := p.GetOverallRating(); got != 40 || got == before {
+		t.Errorf("GetOverallRating() = %d after reweighting to speed alone, want 40 (was %d)", got, before)
+	}
+	if got := p.RecomputeRating(); got != 40 {
+		t.Errorf("RecomputeRating() = %d, want 40", got)
+	}
+}
+
+func TestRatingReadsDoNotWriteCache(t *testing.T) {
+	p := newTestPlayer("p", PositionMID, 24)
+	p.InvalidateRating()
+
+	p.GetOverallRating()
+	if p.ratingCache.valid {
+		t.Error("reading the rating populated the cache")
+	}
+
+	p.RecomputeRating()
+	if !p.ratingCache.valid {
+		t.Error("RecomputeRating did not populate the cache")
+	}
+	p.InvalidateRating()
+	if p.ratingCache.valid {
+		t.Error("InvalidateRating left the cache valid")
+	}
+}
+
+func TestRatingConcurrentReads(t *testing.T) {
+	p := newTestPlayer("p", PositionMID, 24)
+	p.Attributes.Passing = 80 // Cache is stale
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				p.GetOverallRating()
+				p.GetOverallRatingFloat()
+			}
+		}()
+	}
+	wg.Wait()
+}
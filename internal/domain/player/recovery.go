@@ -0,0 +1,63 @@
+// domain/player/recovery.go
+package player
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// idleMoraleDays is how long a player can go without a match before
+	// morale starts to suffer
+	idleMoraleDays = 10
+	// idleMoraleLoss is the morale lost per idle day beyond that
+	idleMoraleLoss = 0.5
+)
+
+// RecoveryPlan sets the training load during a break between fixtures
+type RecoveryPlan string
+
+const (
+	RecoveryRest        RecoveryPlan = "rest"        // No training
+	RecoveryLight       RecoveryPlan = "light"       // Recovery sessions only
+	RecoveryMaintenance RecoveryPlan = "maintenance" // Normal training schedule
+)
+
+// TrainingIntensity returns the daily training load for the plan
+func (rp RecoveryPlan) TrainingIntensity() float64 {
+	switch rp {
+	case RecoveryRest:
+		return 0
+	case RecoveryMaintenance:
+		return 1.0
+	default:
+		return 0.5
+	}
+}
+
+// ApplyRecoveryOverDays recovers a player across a gap between fixtures,
+// compounding daily recovery up to full fitness and counting down any
+// injury. Very long idle spells also wear on morale.
+func (fm *FitnessManager) ApplyRecoveryOverDays(player *Player, days int, plan RecoveryPlan) error {
+	if days <= 0 {
+		return nil
+	}
+
+	intensity := plan.TrainingIntensity()
+	if plan != RecoveryMaintenance {
+		player.TrainingLoad.Reset()
+	}
+	for day := 0; day < days; day++ {
+		fm.ApplyDailyRecovery(player, intensity)
+		if _, err := player.RecoverFromInjury(1); err != nil {
+			return err
+		}
+	}
+
+	if idle := days - idleMoraleDays; idle > 0 {
+		player.Morale = math.Max(0, player.Morale-float64(idle)*idleMoraleLoss)
+	}
+
+	player.UpdatedAt = time.Now()
+	return nil
+}
@@ -0,0 +1,121 @@
+// domain/player/recovery_test.go
+package player
+
+import (
+	"math"
+	"testing"
+)
+
+func TestApplyRecoveryOverFourteenDays(t *testing.T) {
+	tests := []struct {
+		name        string
+		plan        RecoveryPlan
+		wantMorale  float64
+		keepsLoaded bool
+	}{
+		{"rest", RecoveryRest, 73, false},
+		{"light", RecoveryLight, 73, false},
+		{"maintenance", RecoveryMaintenance, 73, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fm := NewFitnessManager()
+			p := newTestPlayer("p", PositionMID, 33)
+			p.Fitness = 15
+			p.TrainingLoad = TrainingLoad{Type: TrainingPhysical, Sessions: 4}
+
+			if err := fm.ApplyRecoveryOverDays(p, 14, tt.plan); err != nil {
+				t.Fatal(err)
+			}
+			if p.Fitness != 100 {
+				t.Errorf("Fitness = %.1f after a two-week break, want 100", p.Fitness)
+			}
+			// Four idle days past the grace period
+			if math.Abs(p.Morale-tt.wantMorale) > 1e-9 {
+				t.Errorf("Morale = %.1f, want %.1f", p.Morale, tt.wantMorale)
+			}
+			if loaded := p.TrainingLoad.Sessions > 0; loaded != tt.keepsLoaded {
+				t.Errorf("training load kept = %v, want %v", loaded, tt.keepsLoaded)
+			}
+		})
+	}
+}
+
+func TestApplyRecoveryOverDaysCompoundsDailyRecovery(t *testing.T) {
+	fm := NewFitnessManager()
+	looped := newTestPlayer("p", PositionMID, 33)
+	looped.Fitness = 0
+	for day := 0; day < 3; day++ {
+		fm.ApplyDailyRecovery(looped, RecoveryMaintenance.TrainingIntensity())
+	}
+
+	p := newTestPlayer("p", PositionMID, 33)
+	p.Fitness = 0
+	if err := fm.ApplyRecoveryOverDays(p, 3, RecoveryMaintenance); err != nil {
+		t.Fatal(err)
+	}
+	if p.Fitness != looped.Fitness || p.Fitness >= 100 {
+		t.Errorf("Fitness = %.2f over three days, want %.2f as day by day and short of full", p.Fitness, looped.Fitness)
+	}
+	if p.Morale != looped.Morale {
+		t.Errorf("Morale = %.1f after a short gap, want it untouched", p.Morale)
+	}
+}
+
+func TestApplyRecoveryOverDaysPlansAndLimits(t *testing.T) {
+	oneDay := func(plan RecoveryPlan) float64 {
+		p := newTestPlayer("p", PositionMID, 33)
+		p.Fitness = 0
+		if err := NewFitnessManager().ApplyRecoveryOverDays(p, 1, plan); err != nil {
+			t.Fatal(err)
+		}
+		return p.Fitness
+	}
+	if rest, light, maintenance := oneDay(RecoveryRest), oneDay(RecoveryLight), oneDay(RecoveryMaintenance); !(rest > light && light > maintenance) {
+		t.Errorf("one day's recovery: rest %.1f, light %.1f, maintenance %.1f; want lighter plans to recover more",
+			rest, light, maintenance)
+	}
+
+	tests := []struct {
+		name       string
+		days       int
+		wantMorale float64
+	}{
+		{"no gap", 0, 75},
+		{"negative gap", -3, 75},
+		{"grace period", idleMoraleDays, 75},
+		{"long layoff", 60, 50},
+		{"morale floors at zero", 400, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, 33)
+			p.Fitness = 40
+			if err := NewFitnessManager().ApplyRecoveryOverDays(p, tt.days, RecoveryRest); err != nil {
+				t.Fatal(err)
+			}
+			if p.Morale != tt.wantMorale {
+				t.Errorf("Morale = %.1f, want %.1f", p.Morale, tt.wantMorale)
+			}
+			if tt.days <= 0 && p.Fitness != 40 {
+				t.Errorf("Fitness = %.1f with no days elapsed, want 40", p.Fitness)
+			}
+		})
+	}
+}
+
+func TestApplyRecoveryOverDaysHealsInjury(t *testing.T) {
+	p := newTestPlayer("p", PositionMID, 26)
+	if err := p.ApplyInjury(Injury{Type: InjuryKnock, Days: 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := NewFitnessManager().ApplyRecoveryOverDays(p, 14, RecoveryLight); err != nil {
+		t.Fatal(err)
+	}
+	if p.CurrentInjury != nil || p.Status != StatusAvailable {
+		t.Errorf("after 14 days: injury %+v, status %s; want healed and available", p.CurrentInjury, p.Status)
+	}
+}
@@ -0,0 +1,130 @@
+// domain/player/report.go
+package player
+
+import (
+	"math"
+)
+
+// DevelopmentStatus compares a player's progress with their potential
+type DevelopmentStatus string
+
+const (
+	DevelopmentAhead   DevelopmentStatus = "ahead"
+	DevelopmentOnTrack DevelopmentStatus = "on_track"
+	DevelopmentBehind  DevelopmentStatus = "behind"
+)
+
+// Thresholds for comparing actual against expected growth
+const (
+	aheadGrowthRatio  = 1.25
+	behindGrowthRatio = 0.75
+	developmentPeak   = 28 // Age by which growth is expected to finish
+)
+
+// DevelopmentReport summarizes a player's growth over a series of snapshots
+type DevelopmentReport struct {
+	PlayerID         PlayerID
+	Periods          int
+	StartRating      int
+	CurrentRating    int
+	AttributeGrowth  map[string]int
+	FastestImproving string // Empty when no attribute improved
+	FastestGain      int
+	Declining        []string
+	ProjectedPeak    int
+	Status           DevelopmentStatus
+}
+
+// GenerateDevelopmentReport compares attribute snapshots, oldest first and
+// assumed to be taken once a season, to show how a player is developing
+func (dm *DevelopmentManager) GenerateDevelopmentReport(player *Player, snapshots []Attributes) DevelopmentReport {
+	report := DevelopmentReport{
+		PlayerID:        player.ID,
+		AttributeGrowth: make(map[string]int),
+		Declining:       []string{},
+		Status:          DevelopmentOnTrack,
+	}
+
+	current := player.Attributes
+	if len(snapshots) > 0 {
+		current = snapshots[len(snapshots)-1]
+	}
+	first := current
+	if len(snapshots) > 0 {
+		first = snapshots[0]
+	}
+	report.Periods = int(math.Max(0, float64(len(snapshots)-1)))
+	report.StartRating = ratingFor(player, first)
+	report.CurrentRating = ratingFor(player, current)
+
+	// Compare each attribute between the first and latest snapshot
+	current.ForEach(func(name string, value int) {
+		start, _ := first.Get(name)
+		growth := value - start
+		report.AttributeGrowth[name] = growth
+
+		if growth > report.FastestGain {
+			report.FastestImproving = name
+			report.FastestGain = growth
+		}
+		if growth < 0 {
+			report.Declining = append(report.Declining, name)
+		}
+	})
+
+	// Judge against the same sampled ceiling training stops at
+	ceiling := dm.improvementCeiling(player)
+	report.ProjectedPeak = projectPeak(player, current, report.AttributeGrowth, report.Periods, ceiling)
+
+	if report.Periods == 0 {
+		return report
+	}
+
+	// Judge growth against the pace needed to reach potential by the peak
+	startAge := player.Age() - report.Periods
+	gap := float64(ceiling - report.StartRating)
+	if gap <= 0 {
+		return report
+	}
+
+	expected := gap / math.Max(1, float64(developmentPeak-startAge))
+	actual := float64(report.CurrentRating-report.StartRating) / float64(report.Periods)
+	switch {
+	case actual >= expected*aheadGrowthRatio:
+		report.Status = DevelopmentAhead
+	case actual <= expected*behindGrowthRatio:
+		report.Status = DevelopmentBehind
+	}
+
+	return report
+}
+
+// projectPeak extends each attribute's growth rate for as long as the
+// player can still improve it, capped by their growth ceiling
+func projectPeak(player *Player, current Attributes, growth map[string]int, periods, ceiling int) int {
+	if periods == 0 {
+		return ratingFor(player, current)
+	}
+
+	projected := current
+	for _, name := range AttributeNames() {
+		rate := float64(growth[name]) / float64(periods)
+		if rate <= 0 {
+			continue
+		}
+		value, _ := projected.Get(name)
+		for age := player.Age(); current.CanImprove(name, age); age++ {
+			value = clampAttribute(value + int(math.Round(rate)))
+		}
+		projected.Set(name, value)
+	}
+
+	return int(math.Max(float64(ratingFor(player, current)), math.Min(float64(ratingFor(player, projected)), float64(ceiling))))
+}
+
+// ratingFor rates a set of attributes for the player's position
+func ratingFor(player *Player, attrs Attributes) int {
+	rated := *player
+	rated.Attributes = attrs
+	return rated.GetOverallRating()
+}
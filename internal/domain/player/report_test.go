@@ -0,0 +1,99 @@
+// domain/player/report_test.go
+package player
+
+import "testing"
+
+// newReportPlayer creates a 20-year-old midfielder whose potential range
+// runs to 90 but whose sampled growth ceiling is 80
+func newReportPlayer(t *testing.T) *Player {
+	t.Helper()
+	p := newTestPlayer("p", PositionMID, 20)
+	p.Attributes = uniformAttributes(t, p.Attributes, 60)
+	p.Attributes.SetPotentialRange(70, 90)
+	p.Attributes.PotentialCap = 80
+	return p
+}
+
+// uniformAttributes returns a copy of the attributes with every skill set
+// to the same value, so the overall rating equals it
+func uniformAttributes(t *testing.T, a Attributes, value int) Attributes {
+	t.Helper()
+	for _, name := range AttributeNames() {
+		if err := a.Set(name, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return a
+}
+
+func TestGenerateDevelopmentReportStatus(t *testing.T) {
+	// From 60 at 18, reaching the ceiling of 80 by 28 takes two points a
+	// season. Judged against the range's 90 it would take three.
+	tests := []struct {
+		name       string
+		ratings    []int // Overall rating at each season's snapshot
+		wantStatus DevelopmentStatus
+	}{
+		{"ahead", []int{60, 63, 66}, DevelopmentAhead},
+		{"on track", []int{60, 62, 64}, DevelopmentOnTrack},
+		{"behind", []int{60, 60, 61}, DevelopmentBehind},
+		{"already at the ceiling", []int{80, 80, 80}, DevelopmentOnTrack},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newReportPlayer(t)
+			snapshots := []Attributes{}
+			for _, rating := range tt.ratings {
+				snapshots = append(snapshots, uniformAttributes(t, p.Attributes, rating))
+			}
+
+			report := NewDevelopmentManagerWithSeed(1).GenerateDevelopmentReport(p, snapshots)
+			if report.Status != tt.wantStatus {
+				t.Errorf("Status = %s, want %s", report.Status, tt.wantStatus)
+			}
+			if report.Periods != len(tt.ratings)-1 {
+				t.Errorf("Periods = %d, want %d", report.Periods, len(tt.ratings)-1)
+			}
+			if report.StartRating != tt.ratings[0] || report.CurrentRating != tt.ratings[len(tt.ratings)-1] {
+				t.Errorf("ratings %d to %d, want %d to %d", report.StartRating, report.CurrentRating, tt.ratings[0], tt.ratings[len(tt.ratings)-1])
+			}
+			if report.ProjectedPeak < report.CurrentRating || report.ProjectedPeak > 80 {
+				t.Errorf("ProjectedPeak = %d, want between the current %d and the ceiling of 80", report.ProjectedPeak, report.CurrentRating)
+			}
+		})
+	}
+}
+
+func TestGenerateDevelopmentReportFewSnapshots(t *testing.T) {
+	tests := []struct {
+		name       string
+		snapshots  int
+		wantRating int
+	}{
+		{"no snapshots rates the player now", 0, 60},
+		{"one snapshot rates the snapshot", 1, 70},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newReportPlayer(t)
+			snapshots := []Attributes{}
+			for i := 0; i < tt.snapshots; i++ {
+				snapshots = append(snapshots, uniformAttributes(t, p.Attributes, 70))
+			}
+
+			report := NewDevelopmentManagerWithSeed(1).GenerateDevelopmentReport(p, snapshots)
+			if report.Periods != 0 {
+				t.Errorf("Periods = %d, want 0", report.Periods)
+			}
+			if report.StartRating != tt.wantRating || report.CurrentRating != tt.wantRating || report.ProjectedPeak != tt.wantRating {
+				t.Errorf("ratings start %d, current %d, peak %d; want all %d",
+					report.StartRating, report.CurrentRating, report.ProjectedPeak, tt.wantRating)
+			}
+			if report.Status != DevelopmentOnTrack || report.FastestImproving != "" || len(report.Declining) != 0 {
+				t.Errorf("report = %+v, want no movement", report)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// domain/player/retirement.go
+package player
+
+import (
+	"math"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+const (
+	// minRetirementAge is the youngest age at which players consider retiring
+	minRetirementAge = 32
+	// maxRetirementChance keeps even the most worn-out player's decision open
+	maxRetirementChance = 0.95
+)
+
+// ConsiderRetirement decides whether the player hangs up their boots, based
+// on age, declining physique, fitness and form. Stars play on longer. The
+// player's status is set to retired when they do, and the result reports
+// whether that happened.
+func (p *Player) ConsiderRetirement(seed int64) bool {
+	return p.ConsiderRetirementWithSource(common.NewRandSource(seed))
+}
+
+// ConsiderRetirementWithSource decides on retirement like
+// ConsiderRetirement, drawing from the given source
+func (p *Player) ConsiderRetirementWithSource(rng common.RandSource) bool {
+	if p.Status == StatusRetired {
+		return true
+	}
+	if p.RetirementChance() == 0 {
+		return false
+	}
+
+	if rng.Float64() >= p.RetirementChance() {
+		return false
+	}
+
+	if err := p.SetStatus(StatusRetired); err != nil {
+		return false
+	}
+	return true
+}
+
+// RetirementChance returns the probability (0-0.95) that the player
+// retires this season
+func (p *Player) RetirementChance() float64 {
+	age := p.Age()
+	if age < minRetirementAge {
+		return 0
+	}
+
+	chance := 0.05 + 0.12*float64(age-minRetirementAge)
+
+	if p.Form < 50 {
+		chance += (50 - p.Form) / 100
+	}
+	if p.Fitness < 60 {
+		chance += (60 - p.Fitness) / 100
+	}
+	if physique := float64(p.Attributes.Speed+p.Attributes.Stamina) / 2; physique < 50 {
+		chance += 0.1
+	}
+	if p.GetOverallRating() >= 75 {
+		chance *= 0.5
+	}
+
+	return math.Max(0, math.Min(chance, maxRetirementChance))
+}
@@ -0,0 +1,78 @@
+// domain/player/retirement_test.go
+package player
+
+import (
+	"testing"
+)
+
+// newVeteran creates a player of the given age, form and overall rating
+func newVeteran(age int, form float64, rating int) *Player {
+	p := newTestPlayer("vet", PositionMID, age)
+	for _, name := range AttributeNames() {
+		_ = p.Attributes.Set(name, rating)
+	}
+	p.Form = form
+	return p
+}
+
+func TestRetirementChance(t *testing.T) {
+	tests := []struct {
+		name    string
+		player  *Player
+		wantMin float64
+		wantMax float64
+	}{
+		{"too young to consider it", newVeteran(31, 10, 40), 0, 0},
+		{"33-year-old star", newVeteran(33, 80, 85), 0.05, 0.15},
+		{"38-year-old out of form", newVeteran(38, 20, 45), 0.9, maxRetirementChance},
+		{"capped for the oldest", newVeteran(45, 0, 20), maxRetirementChance, maxRetirementChance},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.player.RetirementChance()
+			if got < tt.wantMin || got > tt.wantMax {
+				t.Errorf("RetirementChance() = %.3f, want %.2f-%.2f", got, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
+
+func TestConsiderRetirementLikelierForDecliningVeterans(t *testing.T) {
+	retirements := func(age int, form float64, rating int) int {
+		count := 0
+		for i := 0; i < 1000; i++ {
+			if newVeteran(age, form, rating).ConsiderRetirement(int64(i)) {
+				count++
+			}
+		}
+		return count
+	}
+
+	veteran := retirements(38, 20, 45)
+	star := retirements(33, 80, 85)
+	if veteran < 5*star {
+		t.Errorf("38-year-old out of form retired %d times, 33-year-old star %d; want far more often", veteran, star)
+	}
+}
+
+func TestConsiderRetirementSetsStatus(t *testing.T) {
+	p := newVeteran(45, 0, 20)
+	retired := false
+	for i := 0; i < 20 && !retired; i++ {
+		retired = p.ConsiderRetirement(int64(i))
+	}
+	if !retired || p.Status != StatusRetired {
+		t.Fatalf("ConsiderRetirement = %v, Status = %s; want a retirement", retired, p.Status)
+	}
+	if !p.ConsiderRetirement(1) {
+		t.Error("a retired player un-retired")
+	}
+
+	young := newTestPlayer("young", PositionMID, 24)
+	for i := 0; i < 100; i++ {
+		if young.ConsiderRetirement(int64(i)) || young.Status == StatusRetired {
+			t.Fatal("a 24-year-old retired")
+		}
+	}
+}
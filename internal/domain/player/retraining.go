@@ -0,0 +1,98 @@
+// domain/player/retraining.go
+package player
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// retrainingRate is the conversion progress an average player makes in
+	// one session
+	retrainingRate = 0.02
+	// retrainingAttributeChance is the chance per session of improving each
+	// attribute the new position relies on more
+	retrainingAttributeChance = 0.15
+)
+
+// PositionRetraining tracks a player's conversion to a new position
+type PositionRetraining struct {
+	Target   Position
+	Progress float64 // 0-1, converted at 1
+}
+
+// RetrainPosition runs training sessions converting a player towards a new
+// position. Attributes the new position relies on more improve along the
+// way, and once enough sessions have been completed the player's natural
+// position changes. Younger players with better perception learn faster.
+// Progress carries over between calls; switching target starts afresh.
+// Goalkeepers cannot be converted to outfield roles or the reverse. It
+// reports whether the conversion is complete.
+func (dm *DevelopmentManager) RetrainPosition(player *Player, target Position, sessions int) bool {
+	if player.Position == target {
+		player.Retraining = nil
+		return true
+	}
+	if player.Position == PositionGK || target == PositionGK {
+		return false
+	}
+
+	if player.Retraining == nil || player.Retraining.Target != target {
+		player.Retraining = &PositionRetraining{Target: target}
+	}
+
+	focus := retrainingFocus(player.Position, target)
+	rate := retrainingRate * retrainingAgeFactor(player.Age()) * (0.5 + float64(player.Attributes.Perception)/100)
+
+	for i := 0; i < sessions; i++ {
+		for _, attr := range focus {
+			if dm.rand.Float64() < retrainingAttributeChance {
+				// Focus attributes always exist, so the change cannot fail
+				if improvement := dm.calculateImprovement(player, attr); improvement > 0 {
+					_ = dm.applyAttributeChange(player, attr, improvement)
+				}
+			}
+		}
+
+		player.Retraining.Progress = math.Min(1, player.Retraining.Progress+rate)
+		if player.Retraining.Progress >= 1 {
+			player.Position = target
+			player.Retraining = nil
+			break
+		}
+	}
+
+	player.Attributes.Quality = player.GetOverallRating()
+	player.UpdatedAt = time.Now()
+
+	return player.Retraining == nil
+}
+
+// retrainingFocus returns the attributes the target position weights more
+// heavily than the current one
+func retrainingFocus(from, to Position) []string {
+	current := PositionWeights(from)
+	target := PositionWeights(to)
+
+	focus := []string{}
+	for _, name := range AttributeNames() {
+		if target[name] > current[name] {
+			focus = append(focus, name)
+		}
+	}
+	return focus
+}
+
+// retrainingAgeFactor scales how quickly a player adapts with age
+func retrainingAgeFactor(age int) float64 {
+	switch {
+	case age <= 23:
+		return 1.5
+	case age <= 28:
+		return 1.0
+	case age <= 32:
+		return 0.7
+	default:
+		return 0.5
+	}
+}
@@ -0,0 +1,64 @@
+// domain/player/roles.go
+package player
+
+import "math"
+
+// Contribution areas used by RoleContribution
+const (
+	ContributionDefending = "defending"
+	ContributionCreating  = "creating"
+	ContributionFinishing = "finishing"
+)
+
+// roleEmphasis weights each contribution area by position
+var roleEmphasis = map[Position][3]float64{
+	PositionGK:  {1.0, 0.15, 0.0},
+	PositionDEF: {1.6, 0.8, 0.3},
+	PositionMID: {0.9, 1.3, 0.7},
+	PositionFWD: {0.3, 0.8, 1.6},
+}
+
+// RoleContribution estimates how a player's contribution splits across
+// defending, creating and finishing. The shares sum to 1.0.
+func (p *Player) RoleContribution() map[string]float64 {
+	a := p.Attributes
+
+	defending := float64(a.Tackling) + float64(a.Heading)*0.3 + float64(a.Perception)*0.2
+	if p.Position == PositionGK {
+		defending += float64(a.Keeping) * 1.5
+	}
+	creating := float64(a.Passing) + float64(a.BallControl) + float64(a.Perception)*0.5
+	finishing := float64(a.Shooting) + float64(a.Heading)*0.3 + float64(a.Speed)*0.2
+
+	emphasis, ok := roleEmphasis[p.Position]
+	if !ok {
+		emphasis = [3]float64{1, 1, 1}
+	}
+	defending *= emphasis[0]
+	creating *= emphasis[1]
+	finishing *= emphasis[2]
+
+	total := defending + creating + finishing
+	if total == 0 {
+		return map[string]float64{
+			ContributionDefending: 1.0 / 3,
+			ContributionCreating:  1.0 / 3,
+			ContributionFinishing: 1.0 / 3,
+		}
+	}
+
+	return map[string]float64{
+		ContributionDefending: defending / total,
+		ContributionCreating:  creating / total,
+		ContributionFinishing: finishing / total,
+	}
+}
+
+// Leadership rates a player's influence on teammates (0-100) from age,
+// experience and professionalism
+func (p *Player) Leadership() float64 {
+	ageScore := math.Max(0, math.Min(float64(p.Age()-18)/14*100, 100))
+	experienceScore := math.Min(float64(p.CareerStats.TotalMatches)/4, 100)
+
+	return ageScore*0.35 + experienceScore*0.35 + float64(p.Attributes.Professionalism)*0.3
+}
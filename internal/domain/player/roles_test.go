@@ -0,0 +1,59 @@
+// domain/player/roles_test.go
+package player
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRoleContributionSumsToOne(t *testing.T) {
+	tests := []struct {
+		name     string
+		position Position
+		zeroed   bool // Every attribute at zero
+		wantTop  string
+	}{
+		{"keeper", PositionGK, false, ContributionDefending},
+		{"defender", PositionDEF, false, ContributionDefending},
+		{"midfielder", PositionMID, false, ContributionCreating},
+		{"forward", PositionFWD, false, ContributionFinishing},
+		{"no attributes", PositionMID, true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", tt.position, 25)
+			for _, name := range AttributeNames() {
+				value := 70
+				if tt.zeroed {
+					value = 0
+				}
+				if err := p.Attributes.Set(name, value); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			shares := p.RoleContribution()
+			if len(shares) != 3 {
+				t.Fatalf("RoleContribution() = %v, want three areas", shares)
+			}
+
+			total, top := 0.0, ""
+			for area, share := range shares {
+				if share < 0 || share > 1 {
+					t.Errorf("%s share = %v, want within 0-1", area, share)
+				}
+				total += share
+				if top == "" || share > shares[top] {
+					top = area
+				}
+			}
+			if math.Abs(total-1) > 1e-9 {
+				t.Errorf("shares sum to %v, want 1", total)
+			}
+			if tt.wantTop != "" && top != tt.wantTop {
+				t.Errorf("largest share is %s, want %s in %v", top, tt.wantTop, shares)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+// domain/player/scouting.go
+package player
+
+import (
+	"math"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+// ScoutedAttribute is a scout's reading of a single attribute
+type ScoutedAttribute struct {
+	Value      int
+	Confidence float64 // 0-1, how sure the scout is of the reading
+}
+
+// ScoutReport contains a scout's assessment of a player
+type ScoutReport struct {
+	PlayerID       PlayerID
+	ScoutQuality   int
+	Attributes     map[string]ScoutedAttribute
+	CurrentStars   float64 // 0.5-5 in half-star steps
+	PotentialStars float64 // 0.5-5 in half-star steps
+}
+
+// scoutingVisibility describes how easily an attribute is judged from
+// watching a player, physical traits being the most obvious
+var scoutingVisibility = map[string]float64{
+	"Keeping":     0.9,
+	"Tackling":    0.9,
+	"Passing":     0.85,
+	"Shooting":    0.9,
+	"Heading":     0.9,
+	"Speed":       1.0,
+	"Stamina":     0.95,
+	"Perception":  0.75,
+	"BallControl": 0.85,
+}
+
+// ScoutPlayer produces a report whose accuracy depends on scout quality
+// (0-100). Poor scouts misjudge attributes by several points.
+func ScoutPlayer(p *Player, scoutQuality int, seed int64) ScoutReport {
+	return ScoutPlayerWithSource(p, scoutQuality, common.NewRandSource(seed))
+}
+
+// ScoutPlayerWithSource produces a report like ScoutPlayer, drawing the
+// scout's misjudgements from the given source
+func ScoutPlayerWithSource(p *Player, scoutQuality int, rng common.RandSource) ScoutReport {
+	quality := float64(clampAttribute(scoutQuality)) / 100
+
+	report := ScoutReport{
+		PlayerID:     p.ID,
+		ScoutQuality: scoutQuality,
+		Attributes:   make(map[string]ScoutedAttribute, len(scoutingVisibility)),
+	}
+
+	observed := p.Attributes
+	p.Attributes.ForEach(func(name string, value int) {
+		confidence := quality * scoutingVisibility[name]
+		noise := common.NormFloat64(rng) * (1 - confidence) * 10
+		reading := clampAttribute(value + int(math.Round(noise)))
+		observed.Set(name, reading)
+
+		report.Attributes[name] = ScoutedAttribute{
+			Value:      reading,
+			Confidence: confidence,
+		}
+	})
+
+	// Judge current ability from the scout's own readings
+	scouted := *p
+	scouted.Attributes = observed
+	report.CurrentStars = toStars(float64(scouted.GetOverallRating()))
+
+	// Potential is judged from the publicly visible estimate
+	min, max := p.PotentialEstimate()
+	potential := float64(min+max)/2 + common.NormFloat64(rng)*(1-quality)*10
+	report.PotentialStars = toStars(potential)
+
+	return report
+}
+
+// toStars converts a 0-100 rating to a half-star scale
+func toStars(rating float64) float64 {
+	stars := math.Round(rating/10) / 2
+	return math.Max(0.5, math.Min(stars, 5))
+}
@@ -0,0 +1,49 @@
+// domain/player/scouting_test.go
+package player
+
+import (
+	"math"
+	"testing"
+)
+
+func TestScoutPlayerNoiseShrinksWithQuality(t *testing.T) {
+	p := newTestPlayer("p", PositionMID, 25)
+	for _, name := range AttributeNames() {
+		if err := p.Attributes.Set(name, 50); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// meanError is the average misjudgement over many reports
+	meanError := func(quality int) (float64, float64) {
+		errSum, confidenceSum, readings := 0.0, 0.0, 0
+		for seed := int64(1); seed <= 200; seed++ {
+			report := ScoutPlayer(p, quality, seed)
+			for name, reading := range report.Attributes {
+				actual, ok := p.Attributes.Get(name)
+				if !ok {
+					t.Fatalf("report reads unknown attribute %s", name)
+				}
+				errSum += math.Abs(float64(reading.Value - actual))
+				confidenceSum += reading.Confidence
+				readings++
+			}
+		}
+		return errSum / float64(readings), confidenceSum / float64(readings)
+	}
+
+	lastError, lastConfidence := math.Inf(1), -1.0
+	for _, quality := range []int{0, 25, 50, 75, 100} {
+		errAvg, confidence := meanError(quality)
+		if errAvg >= lastError {
+			t.Errorf("quality %d: mean error %.2f, want below %.2f from a worse scout", quality, errAvg, lastError)
+		}
+		if confidence <= lastConfidence {
+			t.Errorf("quality %d: mean confidence %.2f, want above %.2f from a worse scout", quality, confidence, lastConfidence)
+		}
+		lastError, lastConfidence = errAvg, confidence
+	}
+	if lastError > 1 {
+		t.Errorf("best scout misjudges by %.2f on average, want under a point", lastError)
+	}
+}
@@ -0,0 +1,120 @@
+// domain/player/search.go
+package player
+
+import (
+	"sort"
+	"strings"
+)
+
+// SortKey orders search results
+type SortKey string
+
+const (
+	SortNone    SortKey = ""
+	SortOverall SortKey = "overall"
+	SortAge     SortKey = "age"
+	SortValue   SortKey = "value"
+	SortName    SortKey = "name"
+)
+
+// PlayerQuery filters a pool of players. Zero-valued fields are not
+// applied, so an empty query matches everyone.
+type PlayerQuery struct {
+	Positions   []Position // Any of these positions
+	MinAge      int
+	MaxAge      int
+	MinOverall  int
+	Nationality string // Case-insensitive
+	MaxValue    int64
+	Statuses    []Status // Any of these statuses
+
+	SortBy     SortKey
+	Descending bool
+}
+
+// FindPlayers returns the players matching every filter in the query,
+// sorted by its sort key. Without a sort key the input order is kept.
+func FindPlayers(players []Player, criteria PlayerQuery) []Player {
+	matches := []Player{}
+	for i := range players {
+		if criteria.matches(&players[i]) {
+			matches = append(matches, players[i])
+		}
+	}
+
+	if less := criteria.less(); less != nil {
+		sort.SliceStable(matches, func(i, j int) bool {
+			if criteria.Descending {
+				return less(&matches[j], &matches[i])
+			}
+			return less(&matches[i], &matches[j])
+		})
+	}
+
+	return matches
+}
+
+// matches reports whether a player passes every filter
+func (q PlayerQuery) matches(p *Player) bool {
+	if len(q.Positions) > 0 && !containsPosition(q.Positions, p.Position) {
+		return false
+	}
+
+	age := p.Age()
+	if q.MinAge > 0 && age < q.MinAge {
+		return false
+	}
+	if q.MaxAge > 0 && age > q.MaxAge {
+		return false
+	}
+
+	if q.MinOverall > 0 && p.GetOverallRating() < q.MinOverall {
+		return false
+	}
+	if q.Nationality != "" && !strings.EqualFold(p.Nationality, q.Nationality) {
+		return false
+	}
+	if q.MaxValue > 0 && p.MarketValue > q.MaxValue {
+		return false
+	}
+
+	if len(q.Statuses) > 0 {
+		found := false
+		for _, s := range q.Statuses {
+			if p.Status == s {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// less returns the ascending comparison for the sort key
+func (q PlayerQuery) less() func(a, b *Player) bool {
+	switch q.SortBy {
+	case SortOverall:
+		return func(a, b *Player) bool { return a.GetOverallRatingFloat() < b.GetOverallRatingFloat() }
+	case SortAge:
+		return func(a, b *Player) bool { return a.Age() < b.Age() }
+	case SortValue:
+		return func(a, b *Player) bool { return a.MarketValue < b.MarketValue }
+	case SortName:
+		return func(a, b *Player) bool { return a.FullName() < b.FullName() }
+	default:
+		return nil
+	}
+}
+
+func containsPosition(positions []Position, pos Position) bool {
+	for _, p := range positions {
+		if p == pos {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+// domain/player/search_test.go
+package player
+
+import (
+	"reflect"
+	"testing"
+)
+
+// searchPool builds a scouting pool with varied positions, ages, ratings,
+// nationalities, values and statuses
+func searchPool(t *testing.T) []Player {
+	t.Helper()
+	specs := []struct {
+		id          string
+		pos         Position
+		age         int
+		rating      int
+		nationality string
+		value       int64
+		status      Status
+	}{
+		{"keeper", PositionGK, 31, 78, "England", 8000000, StatusAvailable},
+		{"wonderkid", PositionFWD, 18, 72, "Brazil", 15000000, StatusAvailable},
+		{"striker", PositionFWD, 27, 84, "Brazil", 60000000, StatusAvailable},
+		{"veteran", PositionFWD, 34, 76, "england", 3000000, StatusInjured},
+		{"playmaker", PositionMID, 24, 81, "Spain", 35000000, StatusAvailable},
+		{"loanee", PositionMID, 21, 70, "Spain", 6000000, StatusOnLoan},
+		{"stopper", PositionDEF, 29, 80, "England", 20000000, StatusSuspended},
+	}
+
+	pool := make([]Player, len(specs))
+	for i, s := range specs {
+		p := newTestPlayer(s.id, s.pos, s.age)
+		for _, name := range AttributeNames() {
+			if err := p.Attributes.Set(name, s.rating); err != nil {
+				t.Fatal(err)
+			}
+		}
+		p.Nationality, p.MarketValue, p.Status = s.nationality, s.value, s.status
+		pool[i] = *p
+	}
+	return pool
+}
+
+func TestFindPlayers(t *testing.T) {
+	tests := []struct {
+		name  string
+		query PlayerQuery
+		want  []PlayerID
+	}{
+		{"empty query keeps input order", PlayerQuery{},
+			[]PlayerID{"keeper", "wonderkid", "striker", "veteran", "playmaker", "loanee", "stopper"}},
+		{"young affordable forwards", PlayerQuery{Positions: []Position{PositionFWD}, MaxAge: 23, MaxValue: 20000000},
+			[]PlayerID{"wonderkid"}},
+		{"available Brazilians rated 80 or more", PlayerQuery{Nationality: "Brazil", MinOverall: 80, Statuses: []Status{StatusAvailable}},
+			[]PlayerID{"striker"}},
+		{"nationality ignores case", PlayerQuery{Nationality: "ENGLAND", SortBy: SortAge},
+			[]PlayerID{"stopper", "keeper", "veteran"}},
+		{"age range is inclusive", PlayerQuery{MinAge: 24, MaxAge: 29},
+			[]PlayerID{"striker", "playmaker", "stopper"}},
+		{"midfielders or defenders by value, highest first",
+			PlayerQuery{Positions: []Position{PositionMID, PositionDEF}, SortBy: SortValue, Descending: true},
+			[]PlayerID{"playmaker", "stopper", "loanee"}},
+		{"unavailable players by rating",
+			PlayerQuery{Statuses: []Status{StatusInjured, StatusSuspended, StatusOnLoan}, SortBy: SortOverall},
+			[]PlayerID{"loanee", "veteran", "stopper"}},
+		{"sorted by name", PlayerQuery{MaxValue: 8000000, SortBy: SortName},
+			[]PlayerID{"keeper", "loanee", "veteran"}},
+		{"filters that exclude everyone", PlayerQuery{Positions: []Position{PositionGK}, MaxAge: 25},
+			[]PlayerID{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := searchPool(t)
+			got := []PlayerID{}
+			for _, p := range FindPlayers(pool, tt.query) {
+				got = append(got, p.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindPlayers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindPlayersLeavesPoolUntouched(t *testing.T) {
+	pool := searchPool(t)
+	results := FindPlayers(pool, PlayerQuery{SortBy: SortValue})
+	results[0].MarketValue = 1
+
+	if pool[3].MarketValue != 3000000 {
+		t.Errorf("pool MarketValue = %d after changing a result, want 3000000", pool[3].MarketValue)
+	}
+	if pool[0].ID != "keeper" {
+		t.Errorf("pool reordered by sorting: first is %s", pool[0].ID)
+	}
+}
@@ -0,0 +1,52 @@
+// domain/player/season.go
+package player
+
+// SeasonDevelopment summarizes a season of natural development across a
+// group of players
+type SeasonDevelopment struct {
+	Improved      []PlayerID
+	Declined      []PlayerID
+	Unchanged     []PlayerID
+	Retired       []PlayerID
+	RatingChanges map[PlayerID]int // Overall rating change for each active player
+}
+
+// ProcessSeasonDevelopment advances every player by a season of natural
+// development or decline, then lets veterans consider retirement. Players
+// are processed in order, so the outcome is reproducible for a given
+// random source. Players already retired are left alone.
+func (dm *DevelopmentManager) ProcessSeasonDevelopment(players []*Player) SeasonDevelopment {
+	summary := SeasonDevelopment{
+		Improved:      []PlayerID{},
+		Declined:      []PlayerID{},
+		Unchanged:     []PlayerID{},
+		Retired:       []PlayerID{},
+		RatingChanges: make(map[PlayerID]int),
+	}
+
+	for _, p := range players {
+		if p == nil || p.Status == StatusRetired {
+			continue
+		}
+
+		before := p.GetOverallRating()
+		dm.ProcessNaturalDevelopment(p)
+		change := p.GetOverallRating() - before
+		summary.RatingChanges[p.ID] = change
+
+		switch {
+		case change > 0:
+			summary.Improved = append(summary.Improved, p.ID)
+		case change < 0:
+			summary.Declined = append(summary.Declined, p.ID)
+		default:
+			summary.Unchanged = append(summary.Unchanged, p.ID)
+		}
+
+		if p.ConsiderRetirementWithSource(dm.rand) {
+			summary.Retired = append(summary.Retired, p.ID)
+		}
+	}
+
+	return summary
+}
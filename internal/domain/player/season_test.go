@@ -0,0 +1,149 @@
+// domain/player/season_test.go
+package player
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+// newCohort creates youngsters with room to grow, players in their prime,
+// ageing defenders and one already retired
+func newCohort() []*Player {
+	cohort := []*Player{}
+	for i := 0; i < 8; i++ {
+		p := newTestPlayer(fmt.Sprintf("young%d", i), PositionMID, 18)
+		for _, name := range AttributeNames() {
+			_ = p.Attributes.Set(name, 50)
+		}
+		p.Attributes.Potential = 90
+		p.RecomputeRating()
+		cohort = append(cohort, p)
+	}
+	for i := 0; i < 4; i++ {
+		cohort = append(cohort, newTestPlayer(fmt.Sprintf("prime%d", i), PositionMID, 26))
+	}
+	for i := 0; i < 8; i++ {
+		p := newVeteran(36, 80, 75)
+		p.ID = PlayerID(fmt.Sprintf("veteran%d", i))
+		p.Position = PositionDEF // Defenders lean on the pace and stamina that fade
+		p.RecomputeRating()
+		cohort = append(cohort, p)
+	}
+
+	retired := newTestPlayer("retired", PositionMID, 38)
+	retired.Status = StatusRetired
+	return append(cohort, retired, nil)
+}
+
+// cohortGroup names the part of the cohort a player belongs to
+func cohortGroup(id PlayerID) string {
+	return strings.TrimRight(string(id), "0123456789")
+}
+
+func TestProcessSeasonDevelopmentMixedCohort(t *testing.T) {
+	cohort := newCohort()
+	dm := NewDevelopmentManagerWithSource(common.NewRandSource(4))
+	retired := map[PlayerID]bool{"retired": true}
+	before := map[PlayerID]float64{}
+	for _, p := range cohort {
+		if p != nil {
+			before[p.ID] = p.GetOverallRatingFloat()
+		}
+	}
+
+	for season := 1; season <= 6; season++ {
+		summary := dm.ProcessSeasonDevelopment(cohort)
+
+		for id := range summary.RatingChanges {
+			if retired[id] {
+				t.Errorf("season %d: retired player %s was developed", season, id)
+			}
+		}
+
+		listed := map[PlayerID]string{}
+		for name, ids := range map[string][]PlayerID{
+			"improved":  summary.Improved,
+			"declined":  summary.Declined,
+			"unchanged": summary.Unchanged,
+		} {
+			for _, id := range ids {
+				if other, ok := listed[id]; ok {
+					t.Errorf("season %d: %s listed as both %s and %s", season, id, other, name)
+				}
+				listed[id] = name
+			}
+		}
+		if len(listed) != len(summary.RatingChanges) {
+			t.Errorf("season %d: %d players listed, %d developed", season, len(listed), len(summary.RatingChanges))
+		}
+		for id, change := range summary.RatingChanges {
+			want := "unchanged"
+			if change > 0 {
+				want = "improved"
+			} else if change < 0 {
+				want = "declined"
+			}
+			if listed[id] != want {
+				t.Errorf("season %d: %s changed by %d but listed as %q", season, id, change, listed[id])
+			}
+
+			if group := cohortGroup(id); (group == "young" && change < 0) || (group == "prime" && change != 0) {
+				t.Errorf("season %d: %s changed by %d", season, id, change)
+			}
+		}
+
+		for _, id := range summary.Retired {
+			if cohortGroup(id) != "veteran" {
+				t.Errorf("season %d: %s retired, want only veterans to", season, id)
+			}
+			retired[id] = true
+		}
+	}
+
+	totals := map[string]float64{}
+	for _, p := range cohort {
+		if p == nil {
+			continue
+		}
+		totals[cohortGroup(p.ID)] += p.GetOverallRatingFloat() - before[p.ID]
+		if retired[p.ID] != (p.Status == StatusRetired) {
+			t.Errorf("%s status %s, listed as retired: %v", p.ID, p.Status, retired[p.ID])
+		}
+	}
+	if totals["young"] <= 0 {
+		t.Errorf("youngsters gained %.2f rating over six seasons, want growth", totals["young"])
+	}
+	if totals["veteran"] >= 0 {
+		t.Errorf("veterans gained %.2f rating over six seasons, want decline", totals["veteran"])
+	}
+	if totals["prime"] != 0 {
+		t.Errorf("players in their prime changed by %.2f, want no natural change", totals["prime"])
+	}
+}
+
+func TestProcessSeasonDevelopmentDeterministicForSeed(t *testing.T) {
+	run := func() (SeasonDevelopment, []Attributes) {
+		cohort := newCohort()
+		summary := NewDevelopmentManagerWithSource(common.NewRandSource(9)).ProcessSeasonDevelopment(cohort)
+		attrs := []Attributes{}
+		for _, p := range cohort {
+			if p != nil {
+				attrs = append(attrs, p.Attributes)
+			}
+		}
+		return summary, attrs
+	}
+
+	firstSummary, firstAttrs := run()
+	secondSummary, secondAttrs := run()
+	if !reflect.DeepEqual(firstSummary, secondSummary) {
+		t.Errorf("same seed gave different summaries:\n%+v\n%+v", firstSummary, secondSummary)
+	}
+	if !reflect.DeepEqual(firstAttrs, secondAttrs) {
+		t.Error("same seed developed attributes differently")
+	}
+}
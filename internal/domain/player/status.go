@@ -0,0 +1,73 @@
+// domain/player/status.go
+package player
+
+import (
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+// statusTransitions lists the statuses each status may move to directly
+var statusTransitions = map[Status][]Status{
+	StatusAvailable: {StatusInjured, StatusSuspended, StatusOnLoan, StatusRetired},
+	StatusInjured:   {StatusAvailable, StatusSuspended, StatusRetired},
+	StatusSuspended: {StatusAvailable, StatusInjured, StatusRetired},
+	StatusOnLoan:    {StatusAvailable, StatusInjured, StatusSuspended, StatusRetired},
+	StatusRetired:   {},
+}
+
+// SetStatus moves the player to a new status, enforcing the player
+// lifecycle: retirement is final, an injured player must recover before
+// anything but retiring, a suspension must be served before returning, and
+// a player is only marked injured or suspended with an injury or ban
+// recorded. An injury takes precedence over a ban, which resumes once the
+// player recovers.
+func (p *Player) SetStatus(s Status) error {
+	if p.Status == s {
+		return nil
+	}
+	if err := p.checkStatusTransition(s); err != nil {
+		return err
+	}
+
+	p.Status = s
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// checkStatusTransition reports why a move to a status is not allowed
+func (p *Player) checkStatusTransition(s Status) error {
+	details := map[string]interface{}{
+		"player_id": string(p.ID),
+		"from":      string(p.Status),
+		"to":        string(s),
+	}
+
+	allowed := false
+	for _, next := range statusTransitions[p.Status] {
+		if next == s {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return common.ErrInvalidStatusTransition.WithDetails(details)
+	}
+
+	switch {
+	case p.Status == StatusInjured && s != StatusRetired && p.CurrentInjury != nil:
+		details["reason"] = "injury has not healed"
+		return common.ErrInvalidStatusTransition.WithDetails(details)
+	case s == StatusAvailable && p.SuspensionGames > 0:
+		details["reason"] = "suspension has not been served"
+		return common.ErrInvalidStatusTransition.WithDetails(details)
+	case s == StatusInjured && p.CurrentInjury == nil:
+		details["reason"] = "no injury recorded"
+		return common.ErrInvalidStatusTransition.WithDetails(details)
+	case s == StatusSuspended && p.SuspensionGames <= 0:
+		details["reason"] = "no suspension recorded"
+		return common.ErrInvalidStatusTransition.WithDetails(details)
+	}
+
+	return nil
+}
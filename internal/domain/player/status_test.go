@@ -0,0 +1,186 @@
+// domain/player/status_test.go
+package player
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+func TestSetStatusTransitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    Status
+		to      Status
+		injured bool
+		banned  int
+		wantErr bool
+	}{
+		{"available to injured", StatusAvailable, StatusInjured, true, 0, false},
+		{"injured needs an injury", StatusAvailable, StatusInjured, false, 0, true},
+		{"available to suspended", StatusAvailable, StatusSuspended, false, 2, false},
+		{"suspended needs a ban", StatusAvailable, StatusSuspended, false, 0, true},
+		{"suspended to injured", StatusSuspended, StatusInjured, true, 2, false},
+		{"unhealed injury blocks return", StatusInjured, StatusAvailable, true, 0, true},
+		{"unhealed injury blocks suspension", StatusInjured, StatusSuspended, true, 2, true},
+		{"healed injury with ban resumes suspension", StatusInjured, StatusSuspended, false, 2, false},
+		{"healed injury with ban blocks return", StatusInjured, StatusAvailable, false, 2, true},
+		{"healed injury returns", StatusInjured, StatusAvailable, false, 0, false},
+		{"unserved ban blocks return", StatusSuspended, StatusAvailable, false, 1, true},
+		{"injured may retire", StatusInjured, StatusRetired, true, 0, false},
+		{"retirement is final", StatusRetired, StatusAvailable, false, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, 25)
+			p.Status = tt.from
+			p.SuspensionGames = tt.banned
+			if tt.injured {
+				p.CurrentInjury = &Injury{Type: "hamstring", Days: 10}
+			}
+
+			err := p.SetStatus(tt.to)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetStatus(%s) error = %v, wantErr %v", tt.to, err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, common.ErrInvalidStatusTransition) {
+					t.Errorf("error = %v, want ErrInvalidStatusTransition", err)
+				}
+				if p.Status != tt.from {
+					t.Errorf("Status = %s after rejected move, want %s", p.Status, tt.from)
+				}
+			} else if p.Status != tt.to {
+				t.Errorf("Status = %s, want %s", p.Status, tt.to)
+			}
+		})
+	}
+}
+
+func TestRecoveryResumesOutstandingBan(t *testing.T) {
+	p := newTestPlayer("p", PositionDEF, 27)
+
+	// Banned, then injured before serving it
+	if _, err := p.UpdateMatchStats(0, 0, 0, 1, 6); err != nil {
+		t.Fatalf("UpdateMatchStats: %v", err)
+	}
+	if p.Status != StatusSuspended {
+		t.Fatalf("Status = %s after a red card, want suspended", p.Status)
+	}
+	if err := p.ApplyInjury(Injury{Type: "ankle", Days: 3}); err != nil {
+		t.Fatalf("ApplyInjury: %v", err)
+	}
+	if p.Status != StatusInjured {
+		t.Fatalf("Status = %s after injury, want injured", p.Status)
+	}
+
+	fit, err := p.RecoverFromInjury(3)
+	if err != nil {
+		t.Fatalf("RecoverFromInjury: %v", err)
+	}
+	if !fit {
+		t.Error("RecoverFromInjury reported the player unfit")
+	}
+	if p.Status != StatusSuspended {
+		t.Errorf("Status = %s after recovery, want suspended", p.Status)
+	}
+
+	for p.SuspensionGames > 0 {
+		if err := p.ServeSuspensionMatch(); err != nil {
+			t.Fatalf("ServeSuspensionMatch: %v", err)
+		}
+	}
+	if p.Status != StatusAvailable {
+		t.Errorf("Status = %s after serving the ban, want available", p.Status)
+	}
+}
+
+func TestBanWhileInjuredStartsOnRecovery(t *testing.T) {
+	p := newTestPlayer("p", PositionFWD, 24)
+	if err := p.ApplyInjury(Injury{Type: "knee", Days: 5}); err != nil {
+		t.Fatalf("ApplyInjury: %v", err)
+	}
+
+	update, err := p.UpdateMatchStats(0, 0, 0, 1, 5)
+	if err != nil {
+		t.Fatalf("UpdateMatchStats: %v", err)
+	}
+	if update.Suspension == nil {
+		t.Fatal("red card triggered no suspension")
+	}
+	if p.Status != StatusInjured {
+		t.Errorf("Status = %s, want injured until recovery", p.Status)
+	}
+
+	if _, err := p.RecoverFromInjury(5); err != nil {
+		t.Fatalf("RecoverFromInjury: %v", err)
+	}
+	if p.Status != StatusSuspended || p.SuspensionGames != update.Suspension.Games {
+		t.Errorf("after recovery Status = %s with %d games, want suspended with %d",
+			p.Status, p.SuspensionGames, update.Suspension.Games)
+	}
+}
+
+func TestApplyInjuryKeepsRetiredPlayerRetired(t *testing.T) {
+	p := newTestPlayer("p", PositionGK, 38)
+	p.Status = StatusRetired
+
+	if err := p.ApplyInjury(Injury{Type: "back", Days: 7}); err != nil {
+		t.Fatalf("ApplyInjury: %v", err)
+	}
+	if p.Status != StatusRetired {
+		t.Errorf("Status = %s, want retired", p.Status)
+	}
+	if len(p.InjuryHistory) != 1 {
+		t.Errorf("len(InjuryHistory) = %d, want 1", len(p.InjuryHistory))
+	}
+}
+
+func TestIsSelectable(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     Status
+		fitness    float64
+		minFitness float64
+		want       bool
+	}{
+		{"exactly at threshold", StatusAvailable, 60, 60, true},
+		{"just below threshold", StatusAvailable, 59.99, 60, false},
+		{"above threshold", StatusAvailable, 80, 60, true},
+		{"zero threshold", StatusAvailable, 0, 0, true},
+		{"fit but injured", StatusInjured, 100, 0, false},
+		{"fit but suspended", StatusSuspended, 100, 0, false},
+		{"fit but on loan", StatusOnLoan, 100, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlayer("p", PositionMID, 25)
+			p.Status, p.Fitness = tt.status, tt.fitness
+			if got := p.IsSelectable(tt.minFitness); got != tt.want {
+				t.Errorf("IsSelectable(%v) = %v, want %v", tt.minFitness, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsAvailableUsesDefaultThreshold(t *testing.T) {
+	tests := []struct {
+		fitness float64
+		want    bool
+	}{
+		{MinAvailableFitness, true},
+		{MinAvailableFitness - 0.01, false},
+		{100, true},
+	}
+
+	for _, tt := range tests {
+		p := newTestPlayer("p", PositionMID, 25)
+		p.Fitness = tt.fitness
+		if got := p.IsAvailable(); got != tt.want {
+			t.Errorf("IsAvailable() at fitness %v = %v, want %v", tt.fitness, got, tt.want)
+		}
+	}
+}
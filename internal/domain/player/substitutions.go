@@ -0,0 +1,75 @@
+// domain/player/substitutions.go
+package player
+
+import (
+	"sort"
+)
+
+// substitutionFatigue is the fitness a starter can lose during a match
+// before they should be considered for replacement. On the match fatigue
+// curve a midfielder with average stamina reaches it around the 70th
+// minute, while fitter players last longer.
+const substitutionFatigue = 12.0
+
+// SubRecommendation pairs a tiring player with a fresh replacement
+type SubRecommendation struct {
+	Off              PlayerID
+	On               PlayerID
+	ProjectedFitness float64 // The tiring player's projected fitness
+}
+
+// RecommendSubstitutions flags players on the pitch who are projected to
+// have lost substitutionFatigue fitness by the given minute, or to have
+// fallen into injury risk, and pairs each, most tired first, with the best
+// available bench player of the same position. At most slotsRemaining
+// recommendations are returned.
+func (fm *FitnessManager) RecommendSubstitutions(onPitch []*Player, minute int, available []*Player, slotsRemaining int) []SubRecommendation {
+	type tiring struct {
+		player  *Player
+		fitness float64
+	}
+
+	tired := []tiring{}
+	for _, p := range onPitch {
+		if p == nil {
+			continue
+		}
+		fitness := fm.FitnessAtMinute(p, minute, 1.0)
+		if p.Fitness-fitness >= substitutionFatigue || fitness < fm.injuryThreshold {
+			tired = append(tired, tiring{p, fitness})
+		}
+	}
+	sort.SliceStable(tired, func(i, j int) bool {
+		return tired[i].fitness < tired[j].fitness
+	})
+
+	used := make(map[PlayerID]bool)
+	recommendations := []SubRecommendation{}
+	for _, t := range tired {
+		if len(recommendations) >= slotsRemaining {
+			break
+		}
+
+		var best *Player
+		for _, b := range available {
+			if b == nil || used[b.ID] || !b.IsAvailable() || b.Position != t.player.Position {
+				continue
+			}
+			if best == nil || b.GetOverallRating() > best.GetOverallRating() {
+				best = b
+			}
+		}
+		if best == nil {
+			continue
+		}
+
+		used[best.ID] = true
+		recommendations = append(recommendations, SubRecommendation{
+			Off:              t.player.ID,
+			On:               best.ID,
+			ProjectedFitness: t.fitness,
+		})
+	}
+
+	return recommendations
+}
@@ -0,0 +1,55 @@
+// domain/player/substitutions_test.go
+package player
+
+import "testing"
+
+func TestRecommendSubstitutions(t *testing.T) {
+	fm := NewFitnessManager()
+
+	// starter is a fully fit midfielder with the given stamina
+	starter := func(id string, stamina int) *Player {
+		p := newTestPlayer(id, PositionMID, 26)
+		p.Fitness = 100
+		p.Attributes.Stamina = stamina
+		return p
+	}
+
+	tests := []struct {
+		name    string
+		onPitch []*Player
+		minute  int
+		want    []PlayerID
+	}{
+		{"low stamina starter tires by 65'", []*Player{starter("weak", 20), starter("strong", 80)}, 65, []PlayerID{"weak"}},
+		{"nobody tired at half time", []*Player{starter("weak", 20), starter("strong", 80)}, 45, nil},
+		{"average stamina lasts past the hour", []*Player{starter("average", 50)}, 60, nil},
+		{"average stamina tires late on", []*Player{starter("average", 50)}, 80, []PlayerID{"average"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bench := []*Player{starter("sub1", 70), starter("sub2", 70)}
+
+			recs := fm.RecommendSubstitutions(tt.onPitch, tt.minute, bench, 3)
+			if len(recs) != len(tt.want) {
+				t.Fatalf("got %d recommendations %+v, want %v", len(recs), recs, tt.want)
+			}
+			for i, rec := range recs {
+				if rec.Off != tt.want[i] {
+					t.Errorf("recommendation %d takes off %s, want %s", i, rec.Off, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRecommendSubstitutionsFlagsUnfitStarters(t *testing.T) {
+	p := newTestPlayer("p", PositionMID, 26)
+	p.Fitness = 42 // Started the match short of fitness
+	bench := []*Player{newTestPlayer("sub", PositionMID, 26)}
+
+	recs := NewFitnessManager().RecommendSubstitutions([]*Player{p}, 20, bench, 3)
+	if len(recs) != 1 || recs[0].Off != p.ID {
+		t.Errorf("RecommendSubstitutions = %+v, want the unfit starter replaced", recs)
+	}
+}
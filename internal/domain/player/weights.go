@@ -0,0 +1,186 @@
+// domain/player/weights.go
+package player
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+)
+
+// weightTolerance is how far a weight table may stray from summing to 1
+const weightTolerance = 0.01
+
+var (
+	weightsMu sync.RWMutex
+
+	// positionWeights holds the contribution of each attribute to a
+	// position's overall rating
+	positionWeights = map[Position]map[string]float64{
+		PositionGK: {
+			"Keeping":    0.5,
+			"Speed":      0.1,
+			"Perception": 0.2,
+			"Stamina":    0.1,
+			"Passing":    0.1,
+		},
+		PositionDEF: {
+			"Tackling":   0.3,
+			"Heading":    0.2,
+			"Speed":      0.15,
+			"Stamina":    0.15,
+			"Passing":    0.1,
+			"Perception": 0.1,
+		},
+		PositionMID: {
+			"Passing":     0.25,
+			"BallControl": 0.2,
+			"Perception":  0.15,
+			"Stamina":     0.15,
+			"Tackling":    0.15,
+			"Shooting":    0.1,
+		},
+		PositionFWD: {
+			"Shooting":    0.3,
+			"BallControl": 0.2,
+			"Speed":       0.2,
+			"Heading":     0.15,
+			"Perception":  0.15,
+		},
+	}
+
+	// weightsVersion counts weight table changes, so ratings cached under
+	// older weights can be recognized as stale. It is bumped under weightsMu
+	// but read without it, keeping rating cache hits lock-free.
+	weightsVersion atomic.Uint64
+)
+
+// PositionWeights returns a copy of the rating weights for a position, or
+// nil for an unknown position
+func PositionWeights(pos Position) map[string]float64 {
+	weightsMu.RLock()
+	defer weightsMu.RUnlock()
+
+	weights, ok := positionWeights[pos]
+	if !ok {
+		return nil
+	}
+	copied := make(map[string]float64, len(weights))
+	for name, w := range weights {
+		copied[name] = w
+	}
+	return copied
+}
+
+// SetPositionWeights overrides the rating weights for a position
+func SetPositionWeights(pos Position, weights map[string]float64) error {
+	if err := ValidateWeights(weights); err != nil {
+		return err
+	}
+
+	copied := make(map[string]float64, len(weights))
+	for name, w := range weights {
+		copied[name] = w
+	}
+
+	weightsMu.Lock()
+	defer weightsMu.Unlock()
+
+	if _, ok := positionWeights[pos]; !ok {
+		return fmt.Errorf("unknown position %q", pos)
+	}
+	positionWeights[pos] = copied
+	weightsVersion.Add(1)
+	return nil
+}
+
+// ValidateWeights checks that weights name known attributes, are not
+// negative and sum to 1
+func ValidateWeights(weights map[string]float64) error {
+	var a Attributes
+	total := 0.0
+	for name, w := range weights {
+		if _, ok := a.Get(name); !ok {
+			return fmt.Errorf("unknown attribute %q", name)
+		}
+		if w < 0 {
+			return fmt.Errorf("attribute %s has negative weight %.2f", name, w)
+		}
+		total += w
+	}
+
+	if math.Abs(total-1) > weightTolerance {
+		return fmt.Errorf("weights sum to %.3f, expected 1", total)
+	}
+	return nil
+}
+
+// GetRatingWithProfile rates the attributes against a custom role profile,
+// such as a ball-playing defender. Unknown attributes and negative weights
+// are ignored, the rest are normalized to sum to 1, and the result is
+// clamped to 0-100.
+func (a *Attributes) GetRatingWithProfile(weights map[string]float64) int {
+	normalized := make(map[string]float64, len(weights))
+	total := 0.0
+	for name, w := range weights {
+		if _, ok := a.Get(name); !ok || w <= 0 {
+			continue
+		}
+		normalized[name] = w
+		total += w
+	}
+	if total == 0 {
+		return 0
+	}
+
+	for name := range normalized {
+		normalized[name] /= total
+	}
+	return clampAttribute(a.weightedRating(normalized))
+}
+
+// positionRating rates the attributes using a position's weights
+func (a *Attributes) positionRating(pos Position) int {
+	return roundRating(a.positionScore(pos))
+}
+
+// positionScore is the unrounded rating for a position
+func (a *Attributes) positionScore(pos Position) float64 {
+	score, _ := a.versionedPositionScore(pos)
+	return score
+}
+
+// versionedPositionScore is positionScore along with the version of the
+// weights it was computed from
+func (a *Attributes) versionedPositionScore(pos Position) (float64, uint64) {
+	weightsMu.RLock()
+	defer weightsMu.RUnlock()
+
+	return a.weightedScore(positionWeights[pos]), weightsVersion.Load()
+}
+
+// currentWeightsVersion returns the version of the weight tables
+func currentWeightsVersion() uint64 {
+	return weightsVersion.Load()
+}
+
+// weightedRating rounds the weighted score to the nearest whole rating
+func (a *Attributes) weightedRating(weights map[string]float64) int {
+	return roundRating(a.weightedScore(weights))
+}
+
+// weightedScore combines attributes in canonical order so the result
+// does not depend on map iteration
+func (a *Attributes) weightedScore(weights map[string]float64) float64 {
+	total := 0.0
+	a.ForEach(func(name string, value int) {
+		total += float64(value) * weights[name]
+	})
+	return total
+}
+
+// roundRating rounds a score to the nearest rating, halves rounding up
+func roundRating(score float64) int {
+	// Guard against float error pulling an exact half below the boundary
+	return int(math.Floor(score + 0.5 + 1e-9))
+}
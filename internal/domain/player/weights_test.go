@@ -0,0 +1,87 @@
+// domain/player/weights_test.go
+package player
+
+import "testing"
+
+func TestGetRatingWithProfile(t *testing.T) {
+	attrs := NewDefaultAttributes(PositionDEF)
+	attrs.Tackling, attrs.Passing, attrs.BallControl = 80, 70, 75
+
+	defender := PositionWeights(PositionDEF)
+	doubled := map[string]float64{}
+	for name, w := range defender {
+		doubled[name] = w * 2
+	}
+	noisy := PositionWeights(PositionDEF)
+	noisy["Dribbling"] = 0.5
+	noisy["Shooting"] = -0.3
+
+	tests := []struct {
+		name    string
+		weights map[string]float64
+		want    int
+	}{
+		{"standard defender profile", defender, attrs.GetDefenderRating()},
+		{"unnormalized weights", doubled, attrs.GetDefenderRating()},
+		{"unknown and negative weights ignored", noisy, attrs.GetDefenderRating()},
+		{"single attribute", map[string]float64{"Passing": 3}, 70},
+		{"ball-playing defender", map[string]float64{"Tackling": 0.5, "Passing": 0.5}, 75},
+		{"empty profile", map[string]float64{}, 0},
+		{"no usable weights", map[string]float64{"Passing": 0, "Vision": 1}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := attrs.GetRatingWithProfile(tt.weights); got != tt.want {
+				t.Errorf("GetRatingWithProfile() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetRatingWithProfileStaysInRange(t *testing.T) {
+	tests := []struct {
+		name  string
+		value int
+	}{
+		{"floor", 0},
+		{"ceiling", 100},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attrs Attributes
+			for _, name := range AttributeNames() {
+				if err := attrs.Set(name, tt.value); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if got := attrs.GetRatingWithProfile(PositionWeights(PositionFWD)); got != tt.value {
+				t.Errorf("GetRatingWithProfile() = %d, want %d", got, tt.value)
+			}
+		})
+	}
+}
+
+func TestValidateWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights map[string]float64
+		wantErr bool
+	}{
+		{"built-in profile", PositionWeights(PositionMID), false},
+		{"within tolerance", map[string]float64{"Passing": 0.5, "Tackling": 0.495}, false},
+		{"unknown attribute", map[string]float64{"Vision": 1}, true},
+		{"negative weight", map[string]float64{"Passing": 1.5, "Tackling": -0.5}, true},
+		{"sum too low", map[string]float64{"Passing": 0.5}, true},
+		{"empty", map[string]float64{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateWeights(tt.weights); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWeights() = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
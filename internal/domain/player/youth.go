@@ -0,0 +1,180 @@
+// domain/player/youth.go
+package player
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+// Youth intake bounds
+const (
+	youthMinAge       = 16
+	youthMaxAge       = 18
+	youthMinIntake    = 3
+	youthMaxIntake    = 6
+	youthMinPotential = 40
+	youthMaxPotential = 99
+	regenFacility     = 50 // Academy standard assumed for regens
+
+	youthBasePotential     = 50.0
+	facilityPotential      = 0.3 // Potential gained per point of facilities
+	reputationPotential    = 0.2 // Potential gained per point of reputation above average
+	defaultPotentialSpread = 8.0
+)
+
+// YouthConfig shapes the prospects an academy produces
+type YouthConfig struct {
+	PotentialMean   float64       // Average potential of a prospect
+	PotentialSpread float64       // Standard deviation of potential
+	Names           NameGenerator // nil uses the default generator
+}
+
+// DefaultYouthConfig returns the intake configuration for an academy with
+// the given facilities (0-100)
+func DefaultYouthConfig(facilityRating int) YouthConfig {
+	facility := math.Max(0, math.Min(float64(facilityRating), 100))
+	return YouthConfig{
+		PotentialMean:   youthBasePotential + facility*facilityPotential,
+		PotentialSpread: defaultPotentialSpread,
+	}
+}
+
+// ReputationYouthConfig returns the intake configuration for a club of the
+// given reputation (0-100, 50 average). Bigger clubs attract and produce
+// higher-ceiling prospects.
+func ReputationYouthConfig(facilityRating, reputation int) YouthConfig {
+	config := DefaultYouthConfig(facilityRating)
+	rep := math.Max(0, math.Min(float64(reputation), 100))
+	config.PotentialMean += (rep - 50) * reputationPotential
+	return config
+}
+
+// names returns the configured name generator or the default
+func (c YouthConfig) names() NameGenerator {
+	if c.Names == nil {
+		return NewNameGenerator()
+	}
+	return c.Names
+}
+
+// GenerateIntake produces a reproducible batch of 16-18 year old prospects.
+// Better facilities (0-100) raise the prospects' hidden potential.
+func GenerateIntake(teamID string, facilityRating int, seed int64) []Player {
+	return GenerateIntakeWithSource(teamID, facilityRating, common.NewRandSource(seed))
+}
+
+// GenerateIntakeWithSource produces an intake like GenerateIntake, drawing
+// from the given source
+func GenerateIntakeWithSource(teamID string, facilityRating int, rng common.RandSource) []Player {
+	return GenerateIntakeWithNames(teamID, facilityRating, rng, NewNameGenerator())
+}
+
+// GenerateIntakeWithNames produces an intake like GenerateIntakeWithSource,
+// naming the prospects with the given generator
+func GenerateIntakeWithNames(teamID string, facilityRating int, rng common.RandSource, names NameGenerator) []Player {
+	config := DefaultYouthConfig(facilityRating)
+	config.Names = names
+	return GenerateIntakeWithConfig(teamID, rng, config)
+}
+
+// GenerateIntakeWithConfig produces an intake whose potential
+// follows the given configuration
+func GenerateIntakeWithConfig(teamID string, rng common.RandSource, config YouthConfig) []Player {
+	names := config.names()
+	batch := common.SeedFrom(rng)
+
+	count := youthMinIntake + rng.Intn(youthMaxIntake-youthMinIntake+1)
+	intake := make([]Player, 0, count)
+
+	for i := 0; i < count; i++ {
+		id := PlayerID(fmt.Sprintf("%s-youth-%d-%d", teamID, batch, i))
+		intake = append(intake, newYouthProspect(rng, names, id, teamID, "", config))
+	}
+
+	return intake
+}
+
+// GenerateRegen creates a youth prospect to succeed a retiring player. The
+// regen shares only the retiree's position and club, with fresh random
+// attributes and potential.
+func GenerateRegen(retired *Player, seed int64) Player {
+	return GenerateRegenWithSource(retired, common.NewRandSource(seed))
+}
+
+// GenerateRegenWithSource creates a regen like GenerateRegen, drawing from
+// the given source
+func GenerateRegenWithSource(retired *Player, rng common.RandSource) Player {
+	id := PlayerID(fmt.Sprintf("%s-regen-%d", retired.ID, common.SeedFrom(rng)))
+	return newYouthProspect(rng, NewNameGenerator(), id, retired.CurrentTeamID, retired.Position, DefaultYouthConfig(regenFacility))
+}
+
+// newYouthProspect creates a single 16-18 year old, picking a random
+// position when none is given
+func newYouthProspect(rng common.RandSource, names NameGenerator, id PlayerID, teamID string, position Position, config YouthConfig) Player {
+	age := youthMinAge + rng.Intn(youthMaxAge-youthMinAge+1)
+	dob := time.Now().AddDate(-age, 0, -rng.Intn(365)-1)
+
+	name := names.Generate(rng)
+	if position == "" {
+		position = youthPosition(rng)
+	}
+
+	p := NewPlayer(id, name.FirstName, name.LastName, position, dob)
+	p.Nationality = name.Nationality
+	p.CurrentTeamID = teamID
+	p.Attributes = youthAttributes(rng, p.Position, config)
+	p.Attributes.Quality = p.GetOverallRating()
+	p.MarketValue = int64(p.Attributes.Potential) * 10000
+	p.Wage = 500
+
+	return *p
+}
+
+// youthPosition picks a position, weighted towards outfield roles
+func youthPosition(rng common.RandSource) Position {
+	roll := rng.Float64()
+	switch {
+	case roll < 0.1:
+		return PositionGK
+	case roll < 0.4:
+		return PositionDEF
+	case roll < 0.75:
+		return PositionMID
+	default:
+		return PositionFWD
+	}
+}
+
+// youthAttributes creates raw attributes for a prospect: well below the
+// senior defaults now, with potential drawn from the academy's distribution
+func youthAttributes(rng common.RandSource, position Position, config YouthConfig) Attributes {
+	attrs := NewDefaultAttributes(position)
+
+	raw := func(base int) int {
+		v := base - 15 + rng.Intn(11) - 5
+		return int(math.Max(1, math.Min(float64(v), 100)))
+	}
+
+	attrs.ForEach(func(name string, value int) {
+		attrs.Set(name, raw(value))
+	})
+
+	potential := config.PotentialMean + common.NormFloat64(rng)*config.PotentialSpread
+	best := int(math.Max(youthMinPotential, math.Min(math.Round(potential), youthMaxPotential)))
+	spread := 2 + rng.Intn(5)
+	attrs.SetPotentialRange(
+		int(math.Max(youthMinPotential, float64(best-spread))),
+		int(math.Min(youthMaxPotential, float64(best+spread))),
+	)
+
+	attrs.Consistency = 40 + rng.Intn(41)
+	attrs.ImportantMatches = 40 + rng.Intn(41)
+	attrs.Ambition = 40 + rng.Intn(51)
+	attrs.Professionalism = 40 + rng.Intn(51)
+	attrs.InjuryProneness = 20 + rng.Intn(61)
+
+	return attrs
+}
@@ -0,0 +1,106 @@
+// domain/player/youth_test.go
+package player
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+// averagePotential generates many intakes under a config and returns the
+// mean potential of the prospects
+func averagePotential(config YouthConfig) float64 {
+	rng := common.NewRandSource(17)
+	total, count := 0, 0
+	for i := 0; i < 100; i++ {
+		for _, p := range GenerateIntakeWithConfig("club", rng, config) {
+			total += p.Attributes.Potential
+			count++
+		}
+	}
+	return float64(total) / float64(count)
+}
+
+func TestReputationRaisesIntakePotential(t *testing.T) {
+	small := averagePotential(ReputationYouthConfig(60, 10))
+	average := averagePotential(ReputationYouthConfig(60, 50))
+	big := averagePotential(ReputationYouthConfig(60, 90))
+
+	if !(big > average+5 && average > small+5) {
+		t.Errorf("average potential: reputation 90 %.1f, 50 %.1f, 10 %.1f; want clearly higher for bigger clubs",
+			big, average, small)
+	}
+	if poor, good := averagePotential(DefaultYouthConfig(10)), averagePotential(DefaultYouthConfig(90)); good <= poor+10 {
+		t.Errorf("average potential: facilities 90 %.1f, 10 %.1f; want better academies to produce more", good, poor)
+	}
+}
+
+func TestYouthConfigClampsInputs(t *testing.T) {
+	tests := []struct {
+		name string
+		got  YouthConfig
+		want YouthConfig
+	}{
+		{"facilities below 0", DefaultYouthConfig(-20), DefaultYouthConfig(0)},
+		{"facilities above 100", DefaultYouthConfig(150), DefaultYouthConfig(100)},
+		{"reputation below 0", ReputationYouthConfig(50, -5), ReputationYouthConfig(50, 0)},
+		{"reputation above 100", ReputationYouthConfig(50, 300), ReputationYouthConfig(50, 100)},
+		{"average reputation changes nothing", ReputationYouthConfig(50, 50), DefaultYouthConfig(50)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("config = %+v, want %+v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntakePotentialClamped(t *testing.T) {
+	tests := []struct {
+		name   string
+		config YouthConfig
+	}{
+		{"far above the ceiling", YouthConfig{PotentialMean: 200, PotentialSpread: 30}},
+		{"far below the floor", YouthConfig{PotentialMean: -50, PotentialSpread: 30}},
+		{"very wide spread", YouthConfig{PotentialMean: 70, PotentialSpread: 100}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rng := common.NewRandSource(2)
+			for i := 0; i < 50; i++ {
+				for _, p := range GenerateIntakeWithConfig("club", rng, tt.config) {
+					low, high := p.Attributes.PotentialBounds()
+					if low < youthMinPotential || high > youthMaxPotential {
+						t.Fatalf("potential range %d-%d, want within %d-%d", low, high, youthMinPotential, youthMaxPotential)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestIntakeReproducibleBySeed(t *testing.T) {
+	// summary captures the generated parts of an intake, leaving out
+	// timestamps
+	summary := func(seed int64) []string {
+		out := []string{}
+		for _, p := range GenerateIntakeWithConfig("club", common.NewRandSource(seed), ReputationYouthConfig(70, 80)) {
+			low, high := p.Attributes.PotentialBounds()
+			out = append(out, fmt.Sprintf("%s %s %s %s age %d potential %d-%d",
+				p.ID, p.FullName(), p.Position, p.Nationality, p.Age(), low, high))
+		}
+		return out
+	}
+
+	if first, second := summary(5), summary(5); !reflect.DeepEqual(first, second) {
+		t.Errorf("same seed produced different intakes:\n%v\n%v", first, second)
+	}
+	if first, other := summary(5), summary(6); reflect.DeepEqual(first, other) {
+		t.Error("different seeds produced the same intake")
+	}
+}
@@ -0,0 +1,169 @@
+// domain/preview/preview.go
+package preview
+
+import (
+	"math"
+	"sort"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+const (
+	homeAdvantage   = 1.05 // Matches the simulation's home boost
+	strengthPower   = 7.0  // How sharply a strength ratio moves the odds
+	baseDrawChance  = 0.25 // Draw probability between evenly matched teams
+	drawDecay       = 3.0  // How quickly draws become rarer as sides diverge
+	favoriteMargin  = 0.15 // Win probability lead to be favorite, beyond home advantage
+	emptyLineRating = 30.0 // An unfilled line is badly exposed
+)
+
+// lineWeights sets how much each line contributes to overall strength
+var lineWeights = map[player.Position]float64{
+	player.PositionGK:  0.1,
+	player.PositionDEF: 0.3,
+	player.PositionMID: 0.3,
+	player.PositionFWD: 0.3,
+}
+
+// KeyBattle pairs the players most likely to decide a matchup
+type KeyBattle struct {
+	Description    string
+	Attacker       player.PlayerID
+	Defender       player.PlayerID
+	AttackerRating int
+	DefenderRating int
+}
+
+// MatchPreview compares two teams ahead of a match without simulating it
+type MatchPreview struct {
+	HomeTeamID team.TeamID
+	AwayTeamID team.TeamID
+
+	HomeLines map[player.Position]float64
+	AwayLines map[player.Position]float64
+
+	HomeFormation float64 // Tactical matchup multiplier
+	AwayFormation float64
+	HomeMomentum  float64
+	AwayMomentum  float64
+
+	KeyBattles []KeyBattle
+
+	HomeWin float64
+	Draw    float64
+	AwayWin float64
+
+	Favorite            team.TeamID // Empty when too close to call
+	FavoriteProbability float64
+}
+
+// Preview estimates the outcome of a match from each side's line
+// strengths, formation matchup, momentum and key individual battles. The
+// home, draw and away probabilities sum to 1.
+func Preview(home, away *team.Team, homeForm, awayForm team.Formation) MatchPreview {
+	preview := MatchPreview{
+		HomeTeamID:    home.ID,
+		AwayTeamID:    away.ID,
+		HomeLines:     home.GetStrengthByLine(homeForm),
+		AwayLines:     away.GetStrengthByLine(awayForm),
+		HomeFormation: homeForm.GetFormationStrengthAtVenue(awayForm, true),
+		AwayFormation: awayForm.GetFormationStrengthAtVenue(homeForm, false),
+		HomeMomentum:  home.GetMomentum(),
+		AwayMomentum:  away.GetMomentum(),
+	}
+
+	homeRating := overallStrength(preview.HomeLines) * preview.HomeFormation * (1 + preview.HomeMomentum) * homeAdvantage
+	awayRating := overallStrength(preview.AwayLines) * preview.AwayFormation * (1 + preview.AwayMomentum)
+
+	preview.HomeWin, preview.Draw, preview.AwayWin = outcomeProbabilities(homeRating / awayRating)
+
+	switch {
+	case preview.HomeWin-preview.AwayWin >= favoriteMargin:
+		preview.Favorite = home.ID
+		preview.FavoriteProbability = preview.HomeWin
+	case preview.AwayWin-preview.HomeWin >= favoriteMargin:
+		preview.Favorite = away.ID
+		preview.FavoriteProbability = preview.AwayWin
+	}
+
+	preview.KeyBattles = keyBattles(home, away)
+
+	return preview
+}
+
+// overallStrength weights the lines into a single rating
+func overallStrength(lines map[player.Position]float64) float64 {
+	var total float64
+	for pos, weight := range lineWeights {
+		rating := lines[pos]
+		if rating <= 0 {
+			rating = emptyLineRating
+		}
+		total += rating * weight
+	}
+	return total
+}
+
+// outcomeProbabilities converts the ratio of home to away strength into
+// home, draw and away probabilities. Draws are likeliest between evenly
+// matched sides.
+func outcomeProbabilities(ratio float64) (home, draw, away float64) {
+	edge := math.Log(ratio)
+	draw = baseDrawChance * math.Exp(-math.Abs(edge)*drawDecay)
+	homeShare := 1 / (1 + math.Exp(-edge*strengthPower))
+
+	home = (1 - draw) * homeShare
+	away = 1 - draw - home
+	return home, draw, away
+}
+
+// keyBattles pairs each side's best forward against the other's best
+// defender, and the two best midfielders against each other
+func keyBattles(home, away *team.Team) []KeyBattle {
+	battles := []KeyBattle{}
+
+	add := func(description string, attacker, defender *player.Player, attackPos, defendPos player.Position) {
+		if attacker == nil || defender == nil {
+			return
+		}
+		battles = append(battles, KeyBattle{
+			Description:    description,
+			Attacker:       attacker.ID,
+			Defender:       defender.ID,
+			AttackerRating: attacker.GetRatingAtPosition(attackPos),
+			DefenderRating: defender.GetRatingAtPosition(defendPos),
+		})
+	}
+
+	add("Home attack vs away defense",
+		bestAt(home, player.PositionFWD), bestAt(away, player.PositionDEF),
+		player.PositionFWD, player.PositionDEF)
+	add("Away attack vs home defense",
+		bestAt(away, player.PositionFWD), bestAt(home, player.PositionDEF),
+		player.PositionFWD, player.PositionDEF)
+	add("Midfield battle",
+		bestAt(home, player.PositionMID), bestAt(away, player.PositionMID),
+		player.PositionMID, player.PositionMID)
+
+	return battles
+}
+
+// bestAt returns a team's highest rated available player in their natural
+// position, or nil if there is none
+func bestAt(t *team.Team, pos player.Position) *player.Player {
+	candidates := []player.Player{}
+	for _, p := range t.GetAvailablePlayers() {
+		if p.Position == pos {
+			candidates = append(candidates, p)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].GetRatingAtPosition(pos) > candidates[j].GetRatingAtPosition(pos)
+	})
+	return &candidates[0]
+}
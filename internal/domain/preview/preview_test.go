@@ -0,0 +1,101 @@
+// domain/preview/preview_test.go
+package preview
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// newPreviewTeam builds a 4-4-2 squad with every player rated around the
+// given quality
+func newPreviewTeam(t *testing.T, id team.TeamID, quality int) *team.Team {
+	t.Helper()
+	tm := team.NewTeam(id, string(id), team.Stadium{Name: "Ground", Capacity: 20000})
+	counts := map[player.Position]int{
+		player.PositionGK:  2,
+		player.PositionDEF: 5,
+		player.PositionMID: 5,
+		player.PositionFWD: 3,
+	}
+	for pos, n := range counts {
+		for i := 0; i < n; i++ {
+			p := player.NewPlayer(player.PlayerID(fmt.Sprintf("%s-%s%d", id, pos, i)), "Test", "Player", pos, time.Now().AddDate(-25, 0, -1))
+			if err := p.Attributes.ScaleToQuality(quality); err != nil {
+				t.Fatal(err)
+			}
+			p.RecomputeRating()
+			if err := tm.AddPlayer(*p); err != nil {
+				t.Fatalf("AddPlayer(%s): %v", p.ID, err)
+			}
+		}
+	}
+	return tm
+}
+
+func TestPreview(t *testing.T) {
+	tests := []struct {
+		name         string
+		home, away   int // Squad qualities
+		wantFavorite team.TeamID
+	}{
+		{"equal squads", 70, 70, ""},
+		{"stronger home side", 85, 60, "home"},
+		{"stronger away side", 60, 85, "away"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := newPreviewTeam(t, "home", tt.home)
+			away := newPreviewTeam(t, "away", tt.away)
+
+			got := Preview(home, away, team.Formation442, team.Formation442)
+			if sum := got.HomeWin + got.Draw + got.AwayWin; math.Abs(sum-1) > 1e-9 {
+				t.Errorf("probabilities sum to %v, want 1", sum)
+			}
+			if got.Favorite != tt.wantFavorite {
+				t.Errorf("Favorite = %q (home %.3f, draw %.3f, away %.3f), want %q",
+					got.Favorite, got.HomeWin, got.Draw, got.AwayWin, tt.wantFavorite)
+			}
+			if tt.wantFavorite == "" && got.FavoriteProbability != 0 {
+				t.Errorf("FavoriteProbability = %v with no favorite", got.FavoriteProbability)
+			}
+			if len(got.KeyBattles) != 3 {
+				t.Errorf("%d key battles, want 3", len(got.KeyBattles))
+			}
+		})
+	}
+}
+
+func TestOutcomeProbabilities(t *testing.T) {
+	home, draw, away := outcomeProbabilities(1)
+	if home != away {
+		t.Errorf("evenly matched: home %v, away %v, want them equal", home, away)
+	}
+	if draw != baseDrawChance {
+		t.Errorf("evenly matched: draw %v, want %v", draw, baseDrawChance)
+	}
+
+	for _, ratio := range []float64{1e-6, 0.1, 10, 1e6} {
+		home, draw, away := outcomeProbabilities(ratio)
+		if sum := home + draw + away; math.Abs(sum-1) > 1e-9 {
+			t.Errorf("ratio %v: probabilities sum to %v, want 1", ratio, sum)
+		}
+		for _, p := range []float64{home, draw, away} {
+			if p < 0 || p > 1 || math.IsNaN(p) {
+				t.Errorf("ratio %v: probability %v outside 0-1", ratio, p)
+			}
+		}
+		stronger := home
+		if ratio < 1 {
+			stronger = away
+		}
+		if stronger < 0.9 {
+			t.Errorf("ratio %v: stronger side wins %.3f of the time, want nearly always", ratio, stronger)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// domain/team/agegroup.go
+package team
+
+import (
+	"sort"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// Common age group limits
+const (
+	AgeGroupU21 = 21
+	AgeGroupU23 = 23
+)
+
+// GetAgeGroupSquad returns the players eligible for an age group, best
+// rated first
+func (sm *SquadManager) GetAgeGroupSquad(maxAge int) []player.Player {
+	eligible := []player.Player{}
+	for _, p := range sm.team.players() {
+		if p.IsEligibleForAgeGroup(maxAge) {
+			eligible = append(eligible, p)
+		}
+	}
+
+	sort.SliceStable(eligible, func(i, j int) bool {
+		return eligible[i].GetOverallRatingFloat() > eligible[j].GetOverallRatingFloat()
+	})
+
+	return eligible
+}
+
+// RecommendAgeGroupLineup suggests a matchday squad for an age-restricted
+// competition using only eligible players
+func (sm *SquadManager) RecommendAgeGroupLineup(formation Formation, maxAge int) (*Lineup, error) {
+	available := []player.Player{}
+	for _, p := range sm.GetAgeGroupSquad(maxAge) {
+		if p.IsAvailable() {
+			available = append(available, p)
+		}
+	}
+	return sm.recommendLineupFrom(available, formation)
+}
+
+// ValidateAgeGroupLineup checks a lineup and that every named player,
+// substitutes included, is eligible for the age group
+func (sm *SquadManager) ValidateAgeGroupLineup(lineup Lineup, maxAge int) error {
+	if err := sm.team.ValidateLineup(lineup); err != nil {
+		return err
+	}
+
+	named := append(append([]player.PlayerID{}, lineup.Starters...), lineup.Substitutes...)
+	for _, id := range named {
+		p, err := sm.team.GetPlayer(id)
+		if err != nil {
+			return err
+		}
+		if !p.IsEligibleForAgeGroup(maxAge) {
+			return common.ErrAgeIneligible.WithDetails(map[string]interface{}{
+				"player_id": string(id),
+				"age":       p.Age(),
+				"max_age":   maxAge,
+			})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// domain/team/agegroup_test.go
+package team
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+func TestGetAgeGroupSquadBoundary(t *testing.T) {
+	tm := newTestTeam()
+	now := time.Now()
+	squad := []struct {
+		id  string
+		dob time.Time
+	}{
+		{"twenty", now.AddDate(-20, 0, -1)},
+		{"twenty-one-today", now.AddDate(-21, 0, 0)},
+		{"twenty-two-tomorrow", now.AddDate(-22, 0, 1)},
+		{"twenty-two-today", now.AddDate(-22, 0, 0)},
+		{"twenty-five", now.AddDate(-25, 0, -1)},
+	}
+	for i, s := range squad {
+		p := player.NewPlayer(player.PlayerID(s.id), "Test", s.id, player.PositionMID, s.dob)
+		if err := p.Attributes.ScaleToQuality(50 + i*5); err != nil { // Older players rate higher
+			t.Fatal(err)
+		}
+		if err := tm.AddPlayer(*p); err != nil {
+			t.Fatalf("AddPlayer(%s): %v", s.id, err)
+		}
+	}
+	sm := NewSquadManager(tm)
+
+	tests := []struct {
+		maxAge int
+		want   []player.PlayerID
+	}{
+		{AgeGroupU21, []player.PlayerID{"twenty-two-tomorrow", "twenty-one-today", "twenty"}},
+		{AgeGroupU23, []player.PlayerID{"twenty-two-today", "twenty-two-tomorrow", "twenty-one-today", "twenty"}},
+		{19, []player.PlayerID{}},
+	}
+	for _, tt := range tests {
+		got := []player.PlayerID{}
+		for _, p := range sm.GetAgeGroupSquad(tt.maxAge) {
+			got = append(got, p.ID)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("GetAgeGroupSquad(%d) = %v, want %v", tt.maxAge, got, tt.want)
+		}
+	}
+}
+
+func TestAgeGroupLineup(t *testing.T) {
+	tm := newTestTeam()
+	// Midfielders cover every outfield slot, so the lineup never depends on
+	// how equally rated players are ordered
+	counts := map[player.Position]int{player.PositionGK: 1, player.PositionMID: 10}
+	for _, pos := range []player.Position{player.PositionGK, player.PositionMID} {
+		for i := 0; i < counts[pos]; i++ {
+			if err := tm.AddPlayer(newTestPlayer(fmt.Sprintf("%s%d", pos, i), pos, 20)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := tm.AddPlayer(newTestPlayer("veteran", player.PositionMID, 30)); err != nil {
+		t.Fatal(err)
+	}
+	sm := NewSquadManager(tm)
+
+	lineup, err := sm.RecommendAgeGroupLineup(Formation442, AgeGroupU21)
+	if err != nil {
+		t.Fatalf("RecommendAgeGroupLineup: %v", err)
+	}
+	for _, id := range append(lineup.Starters, lineup.Substitutes...) {
+		if id == "veteran" {
+			t.Fatal("recommended an ineligible player")
+		}
+	}
+	if err := sm.ValidateAgeGroupLineup(*lineup, AgeGroupU21); err != nil {
+		t.Errorf("ValidateAgeGroupLineup(recommended) = %v", err)
+	}
+
+	lineup.Substitutes = append(lineup.Substitutes, "veteran")
+	if err := sm.ValidateAgeGroupLineup(*lineup, AgeGroupU21); !errors.Is(err, common.ErrAgeIneligible) {
+		t.Errorf("ValidateAgeGroupLineup(overage substitute) = %v, want %v", err, common.ErrAgeIneligible)
+	}
+
+	tm.RemovePlayer("GK0")
+	if _, err := sm.RecommendAgeGroupLineup(Formation442, AgeGroupU21); err == nil {
+		t.Error("RecommendAgeGroupLineup succeeded without an eligible goalkeeper")
+	}
+}
@@ -0,0 +1,66 @@
+// domain/team/ambition.go
+package team
+
+import (
+	"math"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+const (
+	// unknownPositionAmbition is assumed before a league position is known
+	unknownPositionAmbition = 50
+	// ambitionPerPlace is the ambition lost for each place below first
+	ambitionPerPlace = 3
+	// benchAmbitionPenalty lowers the club's appeal to a player not starting
+	benchAmbitionPenalty = 10
+	// transferUnrestMorale is the morale lost each time an unsettled player
+	// is processed
+	transferUnrestMorale = 3.0
+)
+
+// Ambition rates how ambitious the club appears (0-100), from its league
+// position
+func (t *Team) Ambition() int {
+	position := t.SeasonStats.LeaguePosition
+	if position <= 0 {
+		return unknownPositionAmbition
+	}
+	return int(math.Max(0, float64(100-(position-1)*ambitionPerPlace)))
+}
+
+// ProcessAmbition checks every player's ambition against the club's and
+// the player's place in the side. Players who want to leave hand in a
+// transfer request and their morale keeps slipping until they move. It
+// returns the players currently unsettled.
+func ProcessAmbition(t *Team) []player.PlayerID {
+	regulars := make(map[player.PlayerID]bool)
+	for _, p := range t.GetBestEleven() {
+		regulars[p.ID] = true
+	}
+	clubAmbition := t.Ambition()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	unsettled := []player.PlayerID{}
+	for i := range t.Players {
+		p := &t.Players[i]
+
+		ambition := clubAmbition
+		if !regulars[p.ID] {
+			ambition -= benchAmbitionPenalty
+		}
+		if !p.WantsToLeave(ambition) {
+			continue
+		}
+
+		p.TransferRequested = true
+		p.Morale = math.Max(0, p.Morale-transferUnrestMorale)
+		unsettled = append(unsettled, p.ID)
+	}
+
+	t.UpdatedAt = time.Now()
+	return unsettled
+}
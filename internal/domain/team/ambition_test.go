@@ -0,0 +1,113 @@
+// domain/team/ambition_test.go
+package team
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// newAmbitionTeam creates a settled squad plus an ambitious star who starts
+// and a restless reserve goalkeeper who does not
+func newAmbitionTeam(t *testing.T, leaguePosition int) *Team {
+	t.Helper()
+	tm := newTestTeam()
+	addTestSquad(t, tm, map[player.Position]int{
+		player.PositionGK: 1, player.PositionDEF: 4, player.PositionMID: 4, player.PositionFWD: 2,
+	})
+	tm.UpdatePlayers(func(p *player.Player) { p.Attributes.Ambition = 50 })
+
+	star := newTestPlayer("star", player.PositionMID, 27)
+	if err := star.Attributes.ScaleToQuality(88); err != nil {
+		t.Fatal(err)
+	}
+	star.Attributes.Ambition = 80
+	reserve := newTestPlayer("reserve", player.PositionGK, 27)
+	if err := reserve.Attributes.ScaleToQuality(30); err != nil {
+		t.Fatal(err)
+	}
+	reserve.Attributes.Ambition = 60
+	for _, p := range []player.Player{star, reserve} {
+		if err := tm.AddPlayer(p); err != nil {
+			t.Fatalf("AddPlayer(%s): %v", p.ID, err)
+		}
+	}
+
+	tm.SeasonStats.LeaguePosition = leaguePosition
+	return tm
+}
+
+func TestProcessAmbition(t *testing.T) {
+	tests := []struct {
+		name           string
+		leaguePosition int
+		want           []player.PlayerID
+	}{
+		{"champions keep everyone", 1, []player.PlayerID{}},
+		{"unknown position unsettles the star", 0, []player.PlayerID{"star"}},
+		{"relegation side loses the star and the reserve", 20, []player.PlayerID{"star", "reserve"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newAmbitionTeam(t, tt.leaguePosition)
+			got := ProcessAmbition(tm)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ProcessAmbition() = %v, want %v", got, tt.want)
+			}
+
+			unsettled := map[player.PlayerID]bool{}
+			for _, id := range got {
+				unsettled[id] = true
+			}
+			for _, p := range tm.players() {
+				if p.TransferRequested != unsettled[p.ID] {
+					t.Errorf("%s TransferRequested = %v, want %v", p.ID, p.TransferRequested, unsettled[p.ID])
+				}
+				wantMorale := 75.0
+				if unsettled[p.ID] {
+					wantMorale -= transferUnrestMorale
+				}
+				if p.Morale != wantMorale {
+					t.Errorf("%s morale = %.1f, want %.1f", p.ID, p.Morale, wantMorale)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessAmbitionMoraleKeepsSlipping(t *testing.T) {
+	tm := newAmbitionTeam(t, 20)
+	for i := 0; i < 3; i++ {
+		ProcessAmbition(tm)
+	}
+
+	star, err := tm.GetPlayer("star")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 75 - 3*transferUnrestMorale; star.Morale != want {
+		t.Errorf("star morale = %.1f after three checks, want %.1f", star.Morale, want)
+	}
+}
+
+func TestTeamAmbition(t *testing.T) {
+	tests := []struct {
+		position int
+		want     int
+	}{
+		{0, unknownPositionAmbition},
+		{1, 100},
+		{20, 43},
+		{40, 0},
+	}
+
+	for _, tt := range tests {
+		tm := newTestTeam()
+		tm.SeasonStats.LeaguePosition = tt.position
+		if got := tm.Ambition(); got != tt.want {
+			t.Errorf("Ambition() at position %d = %d, want %d", tt.position, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// domain/team/balance.go
+package team
+
+import (
+	"fmt"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// Squad balance thresholds
+const (
+	agingSquadAge         = 29.0
+	youngSquadAge         = 23.0
+	minYouthProspects     = 3
+	minVeterans           = 2
+	experiencedKeeperAge  = 25
+	experiencedKeeperApps = 50
+	depthPerStarter       = 2 // Players wanted per starting place
+)
+
+// Age distribution bands
+const (
+	AgeBandUnder21 = "under_21"
+	AgeBand21To25  = "21_25"
+	AgeBand26To30  = "26_30"
+	AgeBandOver30  = "over_30"
+)
+
+// BalanceReport is an overall assessment of squad age and depth
+type BalanceReport struct {
+	AverageAge      float64
+	AgeDistribution map[string]int
+	PositionDepth   map[player.Position]int
+	Issues          []string
+	Recommendations []string
+}
+
+// Healthy reports whether no balance problems were found
+func (r BalanceReport) Healthy() bool {
+	return len(r.Issues) == 0
+}
+
+// GetSquadBalanceReport assesses squad age and positional depth against the
+// team's formation and flags problems for squad planning
+func (sm *SquadManager) GetSquadBalanceReport() BalanceReport {
+	report := BalanceReport{
+		AverageAge:      sm.GetSquadAge(),
+		AgeDistribution: map[string]int{},
+		PositionDepth:   map[player.Position]int{},
+		Issues:          []string{},
+		Recommendations: []string{},
+	}
+
+	if sm.team.PlayerCount() == 0 {
+		report.Issues = append(report.Issues, "squad is empty")
+		report.Recommendations = append(report.Recommendations, "Register a full squad before the season starts")
+		return report
+	}
+
+	for _, p := range sm.team.players() {
+		report.AgeDistribution[ageBand(p.Age())]++
+	}
+
+	depth := sm.GetSquadDepth()
+	for pos, players := range depth {
+		report.PositionDepth[pos] = len(players)
+	}
+
+	youth := len(sm.GetYouthProspects())
+	veterans := len(sm.GetVeterans())
+
+	if report.AverageAge > agingSquadAge && youth < minYouthProspects {
+		report.Issues = append(report.Issues, "squad aging, few youngsters")
+		report.Recommendations = append(report.Recommendations, "Promote academy players or sign prospects under 21")
+	}
+	if report.AverageAge < youngSquadAge && veterans < minVeterans {
+		report.Issues = append(report.Issues, "young squad, little experience")
+		report.Recommendations = append(report.Recommendations, "Sign experienced players over 30 to guide the younger squad")
+	}
+
+	if !hasExperiencedKeeper(depth[player.PositionGK]) {
+		report.Issues = append(report.Issues, "no experienced keeper")
+		report.Recommendations = append(report.Recommendations, fmt.Sprintf("Sign a goalkeeper aged %d+ with first-team experience", experiencedKeeperAge))
+	}
+
+	requirements := sm.team.Formation.GetPositionRequirements()
+	for _, pos := range []player.Position{
+		player.PositionGK,
+		player.PositionDEF,
+		player.PositionMID,
+		player.PositionFWD,
+	} {
+		wanted := requirements[pos] * depthPerStarter
+		if have := report.PositionDepth[pos]; have < wanted {
+			report.Issues = append(report.Issues, fmt.Sprintf("thin at %s (%d of %d)", pos, have, wanted))
+			report.Recommendations = append(report.Recommendations, fmt.Sprintf("Add %d %s to cover the %s", wanted-have, pos, sm.team.Formation))
+		}
+	}
+
+	return report
+}
+
+// ageBand places an age in its distribution band
+func ageBand(age int) string {
+	switch {
+	case age < 21:
+		return AgeBandUnder21
+	case age <= 25:
+		return AgeBand21To25
+	case age <= 30:
+		return AgeBand26To30
+	default:
+		return AgeBandOver30
+	}
+}
+
+// hasExperiencedKeeper reports whether any keeper has the age and
+// appearances to be trusted in goal
+func hasExperiencedKeeper(keepers []player.Player) bool {
+	for _, p := range keepers {
+		if p.Age() >= experiencedKeeperAge && p.CareerStats.TotalMatches >= experiencedKeeperApps {
+			return true
+		}
+	}
+	return false
+}
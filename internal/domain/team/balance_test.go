@@ -0,0 +1,66 @@
+// domain/team/balance_test.go
+package team
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// newBalanceSquad builds a squad deep enough for a 4-4-2, with outfield
+// players of one age and two keepers of the given age and appearances
+func newBalanceSquad(t *testing.T, age, keeperAge, keeperApps int) *Team {
+	t.Helper()
+	tm := newTestTeam()
+	tm.Formation = Formation442
+	for _, pos := range []player.Position{player.PositionGK, player.PositionDEF, player.PositionMID, player.PositionFWD} {
+		for i := 0; i < Formation442.GetPositionRequirements()[pos]*depthPerStarter; i++ {
+			playerAge := age
+			if pos == player.PositionGK {
+				playerAge = keeperAge
+			}
+			p := newTestPlayer(fmt.Sprintf("%s%d", pos, i), pos, playerAge)
+			if pos == player.PositionGK {
+				p.CareerStats.TotalMatches = keeperApps
+			}
+			if err := tm.AddPlayer(p); err != nil {
+				t.Fatalf("AddPlayer(%s): %v", p.ID, err)
+			}
+		}
+	}
+	return tm
+}
+
+func TestGetSquadBalanceReport(t *testing.T) {
+	tests := []struct {
+		name      string
+		team      *Team
+		wantIssue string // Empty when the squad should be healthy
+	}{
+		{"balanced", newBalanceSquad(t, 26, 30, 120), ""},
+		{"empty", newTestTeam(), "squad is empty"},
+		{"aging", newBalanceSquad(t, 32, 32, 120), "squad aging, few youngsters"},
+		{"keeper without appearances", newBalanceSquad(t, 26, 30, 10), "no experienced keeper"},
+		{"keeper too young", newBalanceSquad(t, 26, 22, 120), "no experienced keeper"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := NewSquadManager(tt.team).GetSquadBalanceReport()
+
+			if tt.wantIssue == "" {
+				if !report.Healthy() {
+					t.Errorf("Issues = %v, want none", report.Issues)
+				}
+				return
+			}
+			if len(report.Issues) != 1 || report.Issues[0] != tt.wantIssue {
+				t.Errorf("Issues = %v, want [%s]", report.Issues, tt.wantIssue)
+			}
+			if len(report.Recommendations) != len(report.Issues) {
+				t.Errorf("%d recommendations for %d issues", len(report.Recommendations), len(report.Issues))
+			}
+		})
+	}
+}
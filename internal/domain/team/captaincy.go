@@ -0,0 +1,78 @@
+// domain/team/captaincy.go
+package team
+
+import (
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// maxCaptainInfluence is the share of a morale or form swing a perfect
+// leader absorbs for their teammates
+const maxCaptainInfluence = 0.2
+
+// ApplyCaptaincyEffect applies a change to every player in the squad while
+// the captain steadies teammates through it: each teammate's swing in
+// morale and form is shrunk in proportion to the captain's leadership.
+// The captain takes the full change, as does everyone without a captain.
+func (sm *SquadManager) ApplyCaptaincyEffect(change func(p *player.Player)) {
+	var captainID player.PlayerID
+	influence := 0.0
+	if id := sm.team.CaptainID(); id != nil {
+		if captain, err := sm.team.GetPlayer(*id); err == nil {
+			captainID = captain.ID
+			influence = captain.Leadership() / 100 * maxCaptainInfluence
+		}
+	}
+
+	sm.team.UpdatePlayers(func(p *player.Player) {
+		morale, form := p.Morale, p.Form
+		change(p)
+		if p.ID == captainID {
+			return
+		}
+		p.Morale -= (p.Morale - morale) * influence
+		p.Form -= (p.Form - form) * influence
+	})
+}
+
+// EnsureCaptain appoints a successor when the captaincy is vacant, picking
+// the best leadership candidate from the squad by the same measure used
+// for lineups. A vice-captain promoted this way leaves the vice-captaincy
+// vacant. It returns the captain, or nil for an empty squad.
+func (sm *SquadManager) EnsureCaptain() *player.PlayerID {
+	t := sm.team
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.Captain != nil {
+		if _, ok := t.indexOf(*t.Captain); ok {
+			return copyPlayerID(t.Captain)
+		}
+		t.Captain = nil
+	}
+
+	var appointed *player.Player
+	var bestScore float64
+	for i := range t.Players {
+		p := &t.Players[i]
+		if p.Status == player.StatusRetired || p.Status == player.StatusOnLoan {
+			continue
+		}
+		if score := captaincyScore(p); score > bestScore {
+			bestScore = score
+			appointed = p
+		}
+	}
+	if appointed == nil {
+		return nil
+	}
+
+	t.Captain = copyPlayerID(&appointed.ID)
+	if t.ViceCaptain != nil && *t.ViceCaptain == appointed.ID {
+		t.ViceCaptain = nil
+	}
+	t.UpdatedAt = time.Now()
+	return copyPlayerID(t.Captain)
+}
@@ -0,0 +1,221 @@
+// domain/team/captaincy_test.go
+package team
+
+import (
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// newCaptaincyTeam creates a squad of a veteran, a senior pro and a
+// youngster, captained by the veteran
+func newCaptaincyTeam(t *testing.T) *Team {
+	t.Helper()
+	tm := newTestTeam()
+	for _, s := range []struct {
+		id  string
+		age int
+	}{{"veteran", 33}, {"senior", 28}, {"youngster", 19}} {
+		if err := tm.AddPlayer(newTestPlayer(s.id, player.PositionMID, s.age)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tm.SetCaptain("veteran"); err != nil {
+		t.Fatal(err)
+	}
+	return tm
+}
+
+func TestEnsureCaptain(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T, tm *Team)
+		want     player.PlayerID
+		wantVice player.PlayerID // Empty for no vice-captain
+	}{
+		{
+			name: "keeps a sitting captain",
+			want: "veteran",
+		},
+		{
+			name: "replaces a removed captain",
+			setup: func(t *testing.T, tm *Team) {
+				if err := tm.RemovePlayer("veteran"); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: "senior",
+		},
+		{
+			name: "promotes the vice-captain and vacates the role",
+			setup: func(t *testing.T, tm *Team) {
+				if err := tm.SetViceCaptain("senior"); err != nil {
+					t.Fatal(err)
+				}
+				if err := tm.RemovePlayer("veteran"); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: "senior",
+		},
+		{
+			name: "keeps a vice-captain who is passed over",
+			setup: func(t *testing.T, tm *Team) {
+				if err := tm.SetViceCaptain("youngster"); err != nil {
+					t.Fatal(err)
+				}
+				if err := tm.RemovePlayer("veteran"); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want:     "senior",
+			wantVice: "youngster",
+		},
+		{
+			name: "experience counts alongside age",
+			setup: func(t *testing.T, tm *Team) {
+				_ = tm.UpdatePlayer("youngster", func(p *player.Player) { p.CareerStats.TotalMatches = 200 })
+				if err := tm.RemovePlayer("veteran"); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: "youngster",
+		},
+		{
+			name: "loaned-out players are passed over",
+			setup: func(t *testing.T, tm *Team) {
+				_ = tm.UpdatePlayer("senior", func(p *player.Player) { p.Status = player.StatusOnLoan })
+				if err := tm.RemovePlayer("veteran"); err != nil {
+					t.Fatal(err)
+				}
+			},
+			want: "youngster",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newCaptaincyTeam(t)
+			if tt.setup != nil {
+				tt.setup(t, tm)
+			}
+
+			got := NewSquadManager(tm).EnsureCaptain()
+			if got == nil || *got != tt.want {
+				t.Fatalf("EnsureCaptain() = %v, want %s", got, tt.want)
+			}
+			if captain := tm.CaptainID(); captain == nil || *captain != tt.want {
+				t.Errorf("team captain = %v, want %s", captain, tt.want)
+			}
+
+			vice := tm.ViceCaptainID()
+			switch {
+			case tt.wantVice == "" && vice != nil:
+				t.Errorf("vice-captain = %s, want none", *vice)
+			case tt.wantVice != "" && (vice == nil || *vice != tt.wantVice):
+				t.Errorf("vice-captain = %v, want %s", vice, tt.wantVice)
+			}
+		})
+	}
+}
+
+func TestEnsureCaptainEmptySquad(t *testing.T) {
+	tm := newTestTeam()
+	if got := NewSquadManager(tm).EnsureCaptain(); got != nil {
+		t.Errorf("EnsureCaptain() = %s for an empty squad, want nil", *got)
+	}
+	if tm.CaptainID() != nil {
+		t.Error("empty squad has a captain")
+	}
+}
+
+func TestApplyCaptaincyEffect(t *testing.T) {
+	// captained builds a two-player squad, captained by a player of the
+	// given age, experience and professionalism when age is set
+	captained := func(t *testing.T, age, matches, professionalism int) *Team {
+		t.Helper()
+		tm := newTestTeam()
+		mate := newTestPlayer("mate", player.PositionMID, 25)
+		mate.Morale, mate.Form = 70, 70
+		if err := tm.AddPlayer(mate); err != nil {
+			t.Fatal(err)
+		}
+		if age == 0 {
+			return tm
+		}
+
+		captain := newTestPlayer("captain", player.PositionMID, age)
+		captain.Morale, captain.Form = 70, 70
+		captain.CareerStats.TotalMatches = matches
+		captain.Attributes.Professionalism = professionalism
+		if err := tm.AddPlayer(captain); err != nil {
+			t.Fatal(err)
+		}
+		tm.Captain = &captain.ID
+		return tm
+	}
+	// swing applies a heavy blow to morale and form and returns how far the
+	// player fell
+	swing := func(t *testing.T, tm *Team, id player.PlayerID) (float64, float64) {
+		t.Helper()
+		NewSquadManager(tm).ApplyCaptaincyEffect(func(p *player.Player) {
+			p.Morale -= 20
+			p.Form -= 10
+		})
+		p, err := tm.GetPlayer(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return 70 - p.Morale, 70 - p.Form
+	}
+
+	strong := captained(t, 33, 400, 100)
+	weak := captained(t, 19, 0, 10)
+	none := captained(t, 0, 0, 0)
+
+	strongMorale, strongForm := swing(t, strong, "mate")
+	weakMorale, weakForm := swing(t, weak, "mate")
+	noneMorale, noneForm := swing(t, none, "mate")
+
+	if !(strongMorale < weakMorale && weakMorale < noneMorale) {
+		t.Errorf("morale drops strong %.2f, weak %.2f, none %.2f; want a strong captain to soften it most", strongMorale, weakMorale, noneMorale)
+	}
+	if !(strongForm < weakForm && weakForm < noneForm) {
+		t.Errorf("form drops strong %.2f, weak %.2f, none %.2f; want a strong captain to soften it most", strongForm, weakForm, noneForm)
+	}
+	if noneMorale != 20 || noneForm != 10 {
+		t.Errorf("without a captain the drop was %.2f, %.2f; want the full 20, 10", noneMorale, noneForm)
+	}
+
+	captain, err := strong.GetPlayer("captain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if captain.Morale != 50 || captain.Form != 60 {
+		t.Errorf("captain morale %.2f, form %.2f; want the full change", captain.Morale, captain.Form)
+	}
+}
+
+func TestApplyCaptaincyEffectDampensRisesToo(t *testing.T) {
+	tm := newTestTeam()
+	captain := newTestPlayer("captain", player.PositionMID, 33)
+	captain.CareerStats.TotalMatches = 400
+	mate := newTestPlayer("mate", player.PositionMID, 25)
+	mate.Morale = 40
+	for _, p := range []player.Player{captain, mate} {
+		if err := tm.AddPlayer(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tm.Captain = &captain.ID
+
+	NewSquadManager(tm).ApplyCaptaincyEffect(func(p *player.Player) { p.Morale += 30 })
+
+	p, err := tm.GetPlayer("mate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rise := p.Morale - 40; rise <= 0 || rise >= 30 {
+		t.Errorf("morale rose by %.2f, want a softened rise below 30", rise)
+	}
+}
@@ -0,0 +1,85 @@
+// domain/team/chemistry.go
+package team
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+const (
+	// maxChemistryBonus is the largest boost a fully gelled lineup receives
+	maxChemistryBonus = 0.05
+	// nationalityBond is the pair score for sharing a nationality
+	nationalityBond = 0.4
+	// partnershipBond is the pair score for an established partnership
+	partnershipBond = 0.6
+	// matchesForPartnership is how many games together it takes to fully gel
+	matchesForPartnership = 50
+)
+
+// RecordMatchTogether notes that a group of players appeared in a match
+func (t *Team) RecordMatchTogether(playerIDs []player.PlayerID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.SharedMatches == nil {
+		t.SharedMatches = make(map[string]int)
+	}
+
+	for i := 0; i < len(playerIDs); i++ {
+		for j := i + 1; j < len(playerIDs); j++ {
+			t.SharedMatches[pairKey(playerIDs[i], playerIDs[j])]++
+		}
+	}
+}
+
+// MatchesTogether returns how many matches two players have shared
+func (t *Team) MatchesTogether(a, b player.PlayerID) int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return t.SharedMatches[pairKey(a, b)]
+}
+
+// CalculateChemistry returns a strength multiplier (1.0 to 1.05) for a
+// lineup based on shared nationalities and matches played together
+func (sm *SquadManager) CalculateChemistry(lineup Lineup) float64 {
+	starters := []*player.Player{}
+	for _, id := range lineup.Starters {
+		if p, err := sm.team.GetPlayer(id); err == nil {
+			starters = append(starters, p)
+		}
+	}
+
+	pairs := 0
+	total := 0.0
+	for i := 0; i < len(starters); i++ {
+		for j := i + 1; j < len(starters); j++ {
+			a, b := starters[i], starters[j]
+			pairs++
+
+			if a.Nationality != "" && a.Nationality == b.Nationality {
+				total += nationalityBond
+			}
+
+			together := float64(sm.team.MatchesTogether(a.ID, b.ID)) / matchesForPartnership
+			if together > 1 {
+				together = 1
+			}
+			total += together * partnershipBond
+		}
+	}
+
+	if pairs == 0 {
+		return 1.0
+	}
+
+	return 1.0 + maxChemistryBonus*(total/float64(pairs))
+}
+
+// pairKey builds an order-independent key for two players
+func pairKey(a, b player.PlayerID) string {
+	if a > b {
+		a, b = b, a
+	}
+	return string(a) + "|" + string(b)
+}
@@ -0,0 +1,68 @@
+// domain/team/chemistry_test.go
+package team
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// newChemistryTeam returns the 4-4-2 test side with each starter's
+// nationality set by index, and the lineup
+func newChemistryTeam(t *testing.T, nationality func(i int) string) (*Team, Lineup) {
+	t.Helper()
+	tm := newTestTeam()
+	addTestSquad(t, tm, map[player.Position]int{
+		player.PositionGK:  1,
+		player.PositionDEF: 4,
+		player.PositionMID: 4,
+		player.PositionFWD: 2,
+	})
+	lineup := newTestLineup()
+	for i, id := range lineup.Starters {
+		if err := tm.UpdatePlayer(id, func(p *player.Player) { p.Nationality = nationality(i) }); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return tm, lineup
+}
+
+func TestCalculateChemistry(t *testing.T) {
+	allDifferent := func(i int) string { return fmt.Sprintf("N%d", i) }
+
+	tests := []struct {
+		name        string
+		nationality func(i int) string
+		matches     int // Matches the whole XI has played together
+		want        float64
+	}{
+		{"strangers", allDifferent, 0, 1.0},
+		{"one shared nationality", func(i int) string {
+			if i < 2 {
+				return "ENG"
+			}
+			return allDifferent(i)
+		}, 0, 1.0 + maxChemistryBonus*nationalityBond/55}, // One of the 55 pairs in an XI
+		{"fully gelled compatriots", func(int) string { return "ENG" }, matchesForPartnership, 1.05},
+		{"long past gelling", func(int) string { return "ENG" }, 3 * matchesForPartnership, 1.05},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm, lineup := newChemistryTeam(t, tt.nationality)
+			for i := 0; i < tt.matches; i++ {
+				tm.RecordMatchTogether(lineup.Starters)
+			}
+
+			got := NewSquadManager(tm).CalculateChemistry(lineup)
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("CalculateChemistry() = %v, want %v", got, tt.want)
+			}
+			if got < 1.0 || got > 1.05+1e-9 {
+				t.Errorf("CalculateChemistry() = %v, want within 1.0-1.05", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// domain/team/dressingroom.go
+package team
+
+import (
+	"math"
+	"sort"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+const (
+	// unhappyMorale is the morale below which a player becomes disruptive
+	unhappyMorale = 40.0
+	// influentialThreshold is the influence needed to affect teammates
+	influentialThreshold = 0.5
+	// maxMoraleDrag caps how far teammates' morale falls in one application
+	maxMoraleDrag = 10.0
+)
+
+// GetSquadMorale returns the average morale of the squad
+func (t *Team) GetSquadMorale() float64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if len(t.Players) == 0 {
+		return 0
+	}
+
+	var total float64
+	for _, p := range t.Players {
+		total += p.Morale
+	}
+	return total / float64(len(t.Players))
+}
+
+// DressingRoom models how players' moods spread through the squad
+type DressingRoom struct {
+	team *Team
+}
+
+// NewDressingRoom creates a dressing room for a team
+func NewDressingRoom(team *Team) *DressingRoom {
+	return &DressingRoom{team: team}
+}
+
+// Influence rates how much a player's mood sways teammates (0-1), based on
+// seniority, experience and standing in the squad
+func (dr *DressingRoom) Influence(p *player.Player) float64 {
+	seniority := math.Max(0, math.Min(float64(p.Age()-18)/14, 1))
+	experience := math.Min(float64(p.CareerStats.TotalMatches)/300, 1)
+	standing := float64(p.GetOverallRating()) / 100
+
+	influence := seniority*0.4 + experience*0.3 + standing*0.3
+	if captain := dr.team.CaptainID(); captain != nil && *captain == p.ID {
+		influence *= 1.25
+	}
+	return math.Min(influence, 1)
+}
+
+// UnhappyInfluencers returns influential players with low morale, most
+// influential first
+func (dr *DressingRoom) UnhappyInfluencers() []player.PlayerID {
+	type candidate struct {
+		id        player.PlayerID
+		influence float64
+	}
+
+	candidates := []candidate{}
+	squad := dr.team.players()
+	for i := range squad {
+		p := &squad[i]
+		if p.Morale >= unhappyMorale {
+			continue
+		}
+		if influence := dr.Influence(p); influence >= influentialThreshold {
+			candidates = append(candidates, candidate{p.ID, influence})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].influence > candidates[j].influence
+	})
+
+	ids := make([]player.PlayerID, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.id
+	}
+	return ids
+}
+
+// ApplyDressingRoomEffects lowers teammates' morale in proportion to the
+// influence and unhappiness of disruptive players, returning the change
+// applied to each affected player. However many players are disruptive,
+// no teammate loses more than maxMoraleDrag at once.
+func (dr *DressingRoom) ApplyDressingRoomEffects() map[player.PlayerID]float64 {
+	changes := make(map[player.PlayerID]float64)
+
+	disruptive := make(map[player.PlayerID]float64)
+	for _, id := range dr.UnhappyInfluencers() {
+		p, err := dr.team.GetPlayer(id)
+		if err != nil {
+			continue
+		}
+		unhappiness := (unhappyMorale - p.Morale) / unhappyMorale
+		disruptive[id] = dr.Influence(p) * unhappiness * maxMoraleDrag
+	}
+	if len(disruptive) == 0 {
+		return changes
+	}
+
+	dr.team.UpdatePlayers(func(p *player.Player) {
+		var drag float64
+		for id, amount := range disruptive {
+			if id != p.ID {
+				drag += amount
+			}
+		}
+		drag = math.Min(drag, maxMoraleDrag)
+		if drag <= 0 {
+			return
+		}
+
+		before := p.Morale
+		p.Morale = math.Max(0, p.Morale-drag)
+		changes[p.ID] = p.Morale - before
+	})
+
+	return changes
+}
@@ -0,0 +1,92 @@
+// domain/team/dressingroom_test.go
+package team
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// addUnhappyVeterans adds n senior, experienced players with no morale
+func addUnhappyVeterans(t *testing.T, tm *Team, n int) []player.PlayerID {
+	t.Helper()
+	ids := make([]player.PlayerID, n)
+	for i := range ids {
+		p := newTestPlayer(fmt.Sprintf("veteran%d", i), player.PositionMID, 32)
+		p.CareerStats.TotalMatches = 400
+		p.Morale = 0
+		if err := tm.AddPlayer(p); err != nil {
+			t.Fatalf("AddPlayer(%s): %v", p.ID, err)
+		}
+		ids[i] = p.ID
+	}
+	return ids
+}
+
+func TestApplyDressingRoomEffects(t *testing.T) {
+	for _, unhappy := range []int{1, 2} {
+		t.Run(fmt.Sprintf("%d unhappy veterans", unhappy), func(t *testing.T) {
+			tm := newTestTeam()
+			addTestSquad(t, tm, map[player.Position]int{
+				player.PositionGK:  1,
+				player.PositionDEF: 4,
+				player.PositionMID: 4,
+				player.PositionFWD: 2,
+			})
+			veterans := addUnhappyVeterans(t, tm, unhappy)
+			dr := NewDressingRoom(tm)
+
+			var raw float64
+			for _, id := range veterans {
+				p, err := tm.GetPlayer(id)
+				if err != nil {
+					t.Fatal(err)
+				}
+				raw += dr.Influence(p) * maxMoraleDrag
+			}
+			if unhappy > 1 && raw <= maxMoraleDrag {
+				t.Fatalf("combined drag %.2f never reaches the cap", raw)
+			}
+			drag := math.Min(raw, maxMoraleDrag)
+
+			changes := dr.ApplyDressingRoomEffects()
+
+			for _, p := range tm.players() {
+				if p.Morale == 0 {
+					continue // A veteran: only the others drag them down
+				}
+				if got := changes[p.ID]; math.Abs(got+drag) > 1e-9 {
+					t.Errorf("%s morale changed by %.2f, want %.2f", p.ID, got, -drag)
+				}
+				if got := 75 - drag; math.Abs(p.Morale-got) > 1e-9 {
+					t.Errorf("%s morale = %.2f, want %.2f", p.ID, p.Morale, got)
+				}
+			}
+			if unhappy == 1 {
+				if _, ok := changes[veterans[0]]; ok {
+					t.Errorf("the lone veteran's own morale changed by %.2f", changes[veterans[0]])
+				}
+			}
+		})
+	}
+}
+
+func TestDressingRoomContentSquad(t *testing.T) {
+	tm := newTestTeam()
+	addTestSquad(t, tm, map[player.Position]int{
+		player.PositionGK:  1,
+		player.PositionDEF: 4,
+		player.PositionMID: 4,
+		player.PositionFWD: 2,
+	})
+	dr := NewDressingRoom(tm)
+
+	if got := dr.UnhappyInfluencers(); len(got) != 0 {
+		t.Errorf("UnhappyInfluencers() = %v, want none", got)
+	}
+	if got := dr.ApplyDressingRoomEffects(); len(got) != 0 {
+		t.Errorf("ApplyDressingRoomEffects() = %v, want no changes", got)
+	}
+}
@@ -0,0 +1,41 @@
+// domain/team/ffp.go
+package team
+
+import (
+	"time"
+)
+
+// NetSpend sums transfer spending minus transfer income recorded in the
+// ledger between from and to inclusive. Sell-on payments count against
+// income. A negative result means the team made money on transfers.
+func (fm *FinancialManager) NetSpend(from, to time.Time) int64 {
+	fm.team.mu.RLock()
+	defer fm.team.mu.RUnlock()
+
+	var net int64
+	for _, tx := range fm.team.Transactions {
+		if tx.Date.Before(from) || tx.Date.After(to) {
+			continue
+		}
+
+		switch tx.Type {
+		case TransactionTransferIn:
+			// Purchases are spending whichever sign they were recorded with
+			if tx.Amount < 0 {
+				net -= tx.Amount
+			} else {
+				net += tx.Amount
+			}
+		case TransactionTransferOut:
+			net -= tx.Amount
+		}
+	}
+	return net
+}
+
+// IsWithinFFPLimit checks whether net transfer spend over the window up to
+// now stays within a spending cap
+func (fm *FinancialManager) IsWithinFFPLimit(limit int64, window time.Duration) bool {
+	now := time.Now()
+	return fm.NetSpend(now.Add(-window), now) <= limit
+}
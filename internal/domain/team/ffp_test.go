@@ -0,0 +1,104 @@
+// domain/team/ffp_test.go
+package team
+
+import (
+	"testing"
+	"time"
+)
+
+// ledgerEntry is a transaction recorded some days ago
+type ledgerEntry struct {
+	txType  TransactionType
+	amount  int64
+	daysAgo int
+}
+
+// newLedgerTeam records the entries, backdating each one
+func newLedgerTeam(entries []ledgerEntry) (*Team, *FinancialManager) {
+	tm := newTestTeam()
+	fm := NewFinancialManager(tm)
+	for i, e := range entries {
+		fm.RecordTransaction(e.txType, e.amount, "entry", "p")
+		tm.Transactions[i].Date = time.Now().AddDate(0, 0, -e.daysAgo)
+	}
+	return tm, fm
+}
+
+func TestIsWithinFFPLimit(t *testing.T) {
+	const year = 365 * 24 * time.Hour
+
+	tests := []struct {
+		name    string
+		entries []ledgerEntry
+		limit   int64
+		want    bool
+	}{
+		{"big purchase offset by a sale", []ledgerEntry{
+			{TransactionTransferIn, -80000000, 30},
+			{TransactionTransferOut, 50000000, 10},
+		}, 40000000, true},
+		{"same deals over a tighter cap", []ledgerEntry{
+			{TransactionTransferIn, -80000000, 30},
+			{TransactionTransferOut, 50000000, 10},
+		}, 20000000, false},
+		{"spend exactly at the cap", []ledgerEntry{
+			{TransactionTransferIn, -30000000, 5},
+		}, 30000000, true},
+		{"sale outside the window offsets nothing", []ledgerEntry{
+			{TransactionTransferIn, -80000000, 30},
+			{TransactionTransferOut, 50000000, 400},
+		}, 40000000, false},
+		{"purchase outside the window ignored", []ledgerEntry{
+			{TransactionTransferIn, -80000000, 400},
+			{TransactionTransferOut, 10000000, 10},
+		}, 0, true},
+		{"sell-on payment counts as spending", []ledgerEntry{
+			{TransactionTransferIn, -80000000, 30},
+			{TransactionTransferOut, 50000000, 10},
+			{TransactionTransferOut, -15000000, 10},
+		}, 40000000, false},
+		{"wages and other income ignored", []ledgerEntry{
+			{TransactionTransferIn, -30000000, 30},
+			{TransactionWages, -90000000, 20},
+			{TransactionSponsorship, 90000000, 20},
+		}, 30000000, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, fm := newLedgerTeam(tt.entries)
+			if got := fm.IsWithinFFPLimit(tt.limit, year); got != tt.want {
+				t.Errorf("IsWithinFFPLimit(%d) = %v, want %v (net spend %d)",
+					tt.limit, got, tt.want, fm.NetSpend(time.Now().Add(-year), time.Now()))
+			}
+		})
+	}
+}
+
+func TestNetSpend(t *testing.T) {
+	tm, fm := newLedgerTeam([]ledgerEntry{
+		{TransactionTransferIn, -20000000, 60},
+		{TransactionTransferIn, 5000000, 40}, // Recorded as a positive fee
+		{TransactionTransferOut, 12000000, 20},
+	})
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		want     int64
+	}{
+		{"whole ledger", time.Now().AddDate(-1, 0, 0), time.Now(), 13000000},
+		{"purchases only", time.Now().AddDate(0, 0, -90), time.Now().AddDate(0, 0, -30), 25000000},
+		{"sale only makes money", time.Now().AddDate(0, 0, -30), time.Now(), -12000000},
+		{"bounds are inclusive", tm.Transactions[1].Date, tm.Transactions[2].Date, -7000000},
+		{"empty window", time.Now().AddDate(0, 0, -10), time.Now(), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fm.NetSpend(tt.from, tt.to); got != tt.want {
+				t.Errorf("NetSpend() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
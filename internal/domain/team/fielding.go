@@ -0,0 +1,79 @@
+// domain/team/fielding.go
+package team
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// fieldingOrder is the order formation slots are filled and shortages reported
+var fieldingOrder = []player.Position{
+	player.PositionGK,
+	player.PositionDEF,
+	player.PositionMID,
+	player.PositionFWD,
+}
+
+// CanFieldFormation reports whether the available squad can fill every
+// slot of a formation, and if not which positions would be left short.
+// Each player fills at most one slot, so versatile players are not counted
+// twice; they are moved between slots wherever that lets more be filled.
+func (t *Team) CanFieldFormation(f Formation) (bool, []player.Position) {
+	slots := formationSlots(f)
+
+	short := []player.Position{}
+	for _, slot := range unfilledSlots(slots, t.GetAvailablePlayers()) {
+		pos := slots[slot]
+		if len(short) == 0 || short[len(short)-1] != pos {
+			short = append(short, pos)
+		}
+	}
+
+	return len(short) == 0, short
+}
+
+// formationSlots lists a formation's positions, one per slot, in fielding
+// order
+func formationSlots(f Formation) []player.Position {
+	requirements := f.GetPositionRequirements()
+
+	slots := []player.Position{}
+	for _, pos := range fieldingOrder {
+		for i := 0; i < requirements[pos]; i++ {
+			slots = append(slots, pos)
+		}
+	}
+	return slots
+}
+
+// unfilledSlots matches players to slots, each player filling at most one,
+// and returns the indexes of slots left empty by a maximum matching
+func unfilledSlots(slots []player.Position, pool []player.Player) []int {
+	// Maximum matching of slots to players by augmenting paths
+	slotOf := make([]int, len(pool)) // Slot each player fills, or -1
+	for i := range slotOf {
+		slotOf[i] = -1
+	}
+
+	var assign func(slot int, visited []bool) bool
+	assign = func(slot int, visited []bool) bool {
+		for i, p := range pool {
+			if visited[i] || !p.CanPlayPosition(slots[slot]) {
+				continue
+			}
+			visited[i] = true
+			if slotOf[i] == -1 || assign(slotOf[i], visited) {
+				slotOf[i] = slot
+				return true
+			}
+		}
+		return false
+	}
+
+	unfilled := []int{}
+	for slot := range slots {
+		if !assign(slot, make([]bool, len(pool))) {
+			unfilled = append(unfilled, slot)
+		}
+	}
+	return unfilled
+}
@@ -0,0 +1,101 @@
+// domain/team/fielding_test.go
+package team
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+func TestCanFieldFormation(t *testing.T) {
+	tests := []struct {
+		name      string
+		formation Formation
+		counts    map[player.Position]int
+		mutate    func(t *testing.T, tm *Team)
+		wantShort []player.Position
+	}{
+		{
+			name:      "exact squad",
+			formation: Formation442,
+			counts:    map[player.Position]int{player.PositionGK: 1, player.PositionDEF: 4, player.PositionMID: 4, player.PositionFWD: 2},
+			wantShort: []player.Position{},
+		},
+		{
+			name:      "eleven players but no keeper",
+			formation: Formation442,
+			counts:    map[player.Position]int{player.PositionDEF: 4, player.PositionMID: 4, player.PositionFWD: 3},
+			wantShort: []player.Position{player.PositionGK},
+		},
+		{
+			name:      "eleven players but defenders cannot cover midfield",
+			formation: Formation442,
+			counts:    map[player.Position]int{player.PositionGK: 1, player.PositionDEF: 6, player.PositionMID: 2, player.PositionFWD: 2},
+			wantShort: []player.Position{player.PositionMID},
+		},
+		{
+			name:      "midfielders cover defence",
+			formation: Formation442,
+			counts:    map[player.Position]int{player.PositionGK: 1, player.PositionDEF: 2, player.PositionMID: 6, player.PositionFWD: 2},
+			wantShort: []player.Position{},
+		},
+		{
+			name:      "a versatile midfielder fills only one slot",
+			formation: Formation442,
+			counts:    map[player.Position]int{player.PositionGK: 1, player.PositionDEF: 3, player.PositionMID: 4, player.PositionFWD: 2},
+			wantShort: []player.Position{player.PositionMID},
+		},
+		{
+			name:      "forwards cannot drop into midfield",
+			formation: Formation352,
+			counts:    map[player.Position]int{player.PositionGK: 1, player.PositionDEF: 3, player.PositionMID: 4, player.PositionFWD: 3},
+			wantShort: []player.Position{player.PositionMID},
+		},
+		{
+			name:      "a good passer drops into midfield",
+			formation: Formation352,
+			counts:    map[player.Position]int{player.PositionGK: 1, player.PositionDEF: 3, player.PositionMID: 4, player.PositionFWD: 3},
+			mutate: func(t *testing.T, tm *Team) {
+				if err := tm.UpdatePlayer("FWD2", func(p *player.Player) { p.Attributes.Passing = 75 }); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantShort: []player.Position{},
+		},
+		{
+			name:      "unfit keeper",
+			formation: Formation442,
+			counts:    map[player.Position]int{player.PositionGK: 1, player.PositionDEF: 4, player.PositionMID: 4, player.PositionFWD: 2},
+			mutate: func(t *testing.T, tm *Team) {
+				if err := tm.UpdatePlayer("GK0", func(p *player.Player) { p.Fitness = 0 }); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantShort: []player.Position{player.PositionGK},
+		},
+		{
+			name:      "empty squad",
+			formation: Formation433,
+			wantShort: []player.Position{player.PositionGK, player.PositionDEF, player.PositionMID, player.PositionFWD},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newTestTeam()
+			addTestSquad(t, tm, tt.counts)
+			if tt.mutate != nil {
+				tt.mutate(t, tm)
+			}
+
+			ok, short := tm.CanFieldFormation(tt.formation)
+			if !reflect.DeepEqual(short, tt.wantShort) {
+				t.Errorf("short positions = %v, want %v", short, tt.wantShort)
+			}
+			if ok != (len(tt.wantShort) == 0) {
+				t.Errorf("CanFieldFormation() = %v with shortages %v", ok, short)
+			}
+		})
+	}
+}
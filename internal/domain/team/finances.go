@@ -2,9 +2,16 @@
 package team
 
 import (
+	"fmt"
+	"math"
 	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
 )
 
+// weeksPerSeason converts between annual and weekly wage figures
+const weeksPerSeason = 52
+
 // FinancialManager handles team finances
 type FinancialManager struct {
 	team *Team
@@ -30,6 +37,7 @@ const (
 	TransactionTicketSales TransactionType = "ticket_sales"
 	TransactionSponsorship TransactionType = "sponsorship"
 	TransactionPrizeMoney  TransactionType = "prize_money"
+	TransactionStadium     TransactionType = "stadium"
 	TransactionOther       TransactionType = "other"
 )
 
@@ -38,33 +46,68 @@ func NewFinancialManager(team *Team) *FinancialManager {
 	return &FinancialManager{team: team}
 }
 
+// RecordTransaction adds an entry to the ledger and applies it to the
+// budget. Income is positive and spending negative.
+func (fm *FinancialManager) RecordTransaction(txType TransactionType, amount int64, description, playerID string) Transaction {
+	fm.team.mu.Lock()
+	defer fm.team.mu.Unlock()
+
+	return fm.recordTransaction(txType, amount, description, playerID)
+}
+
+// recordTransaction appends to the ledger; the caller must hold the lock
+func (fm *FinancialManager) recordTransaction(txType TransactionType, amount int64, description, playerID string) Transaction {
+	tx := Transaction{
+		ID:          fmt.Sprintf("%s-txn-%d", fm.team.ID, len(fm.team.Transactions)+1),
+		Type:        txType,
+		Amount:      amount,
+		Description: description,
+		Date:        time.Now(),
+		PlayerID:    playerID,
+	}
+	fm.team.Transactions = append(fm.team.Transactions, tx)
+	fm.team.Budget += amount
+	fm.team.UpdatedAt = tx.Date
+
+	return tx
+}
+
 // CanAffordTransfer checks if team can afford a transfer
 func (fm *FinancialManager) CanAffordTransfer(fee int64, wages int64) bool {
-	if fee > fm.team.Budget {
+	fm.team.mu.RLock()
+	budget, wageBudget := fm.team.Budget, fm.team.WageBudget
+	fm.team.mu.RUnlock()
+
+	if fee > budget {
 		return false
 	}
 
 	// Check wage budget
 	currentWages := fm.GetTotalWages()
-	if currentWages+wages > fm.team.WageBudget {
+	if currentWages+wages > wageBudget {
 		return false
 	}
 
 	return true
 }
 
-// GetTotalWages calculates total weekly wages
+// GetTotalWages calculates total weekly wages, net of any share paid by
+// loan clubs
 func (fm *FinancialManager) GetTotalWages() int64 {
 	var total int64
-	for _, p := range fm.team.Players {
-		total += p.Wage
+	for _, p := range fm.team.players() {
+		total += p.WageCost()
 	}
 	return total
 }
 
 // GetWageBudgetRemaining calculates remaining wage budget
 func (fm *FinancialManager) GetWageBudgetRemaining() int64 {
-	return fm.team.WageBudget - fm.GetTotalWages()
+	fm.team.mu.RLock()
+	wageBudget := fm.team.WageBudget
+	fm.team.mu.RUnlock()
+
+	return wageBudget - fm.GetTotalWages()
 }
 
 // ProcessMatchRevenue calculates match day income
@@ -73,11 +116,20 @@ func (fm *FinancialManager) ProcessMatchRevenue(attendance int, isHome bool) int
 		return 0 // Away teams typically don't get gate receipts
 	}
 
+	// The crowd can't exceed the stadium's capacity
+	capacity := fm.team.Stadium.Capacity
+	if attendance > capacity {
+		attendance = capacity
+	}
+	if attendance <= 0 {
+		return 0
+	}
+
 	// Simple calculation: average ticket price * attendance
 	avgTicketPrice := int64(30) // Base price
 
 	// Adjust for stadium utilization
-	utilization := float64(attendance) / float64(fm.team.Stadium.Capacity)
+	utilization := float64(attendance) / float64(capacity)
 	if utilization > 0.9 {
 		avgTicketPrice = int64(float64(avgTicketPrice) * 1.2) // Premium pricing
 	}
@@ -115,6 +167,38 @@ func (fm *FinancialManager) CalculateSeasonBudget(leaguePosition int, cupProgres
 		baseBudget += 500000
 	}
 
+	fm.team.mu.Lock()
+	defer fm.team.mu.Unlock()
+
 	fm.team.Budget = baseBudget
-	fm.team.WageBudget = baseBudget / 52 // Weekly wage budget
+	fm.team.WageBudget = baseBudget / weeksPerSeason // Weekly wage budget
+}
+
+// AllocateBudget redistributes the combined transfer and annual wage funds,
+// giving transferShare (0-1) to transfers and the rest to weekly wages.
+// Any amount too small to add to the weekly wage budget stays with
+// transfers, so the total is unchanged.
+func (fm *FinancialManager) AllocateBudget(transferShare float64) error {
+	if transferShare < 0 || transferShare > 1 || math.IsNaN(transferShare) {
+		return common.ErrInvalidBudgetAllocation.WithDetails(map[string]interface{}{
+			"transfer_share": transferShare,
+		})
+	}
+
+	fm.team.mu.Lock()
+	defer fm.team.mu.Unlock()
+
+	total := fm.team.Budget + fm.team.WageBudget*weeksPerSeason
+	if total < 0 {
+		return common.ErrInvalidBudgetAllocation.WithDetails(map[string]interface{}{
+			"total": total,
+		})
+	}
+
+	wagePool := int64(float64(total) * (1 - transferShare))
+	fm.team.WageBudget = wagePool / weeksPerSeason
+	fm.team.Budget = total - fm.team.WageBudget*weeksPerSeason
+	fm.team.UpdatedAt = time.Now()
+
+	return nil
 }
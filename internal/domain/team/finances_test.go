@@ -0,0 +1,90 @@
+// domain/team/finances_test.go
+package team
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+func TestAllocateBudgetPreservesTotal(t *testing.T) {
+	tests := []struct {
+		name          string
+		budget        int64
+		wageBudget    int64
+		transferShare float64
+		wantWeekly    int64
+	}{
+		{"even split", 5200000, 100000, 0.5, 100000},
+		{"all to transfers", 5200000, 100000, 1, 0},
+		{"all to wages", 5200000, 100000, 0, 200000},
+		{"leftover stays with transfers", 1000, 0, 0, 19},
+		{"negative transfer budget covered by wages", -520000, 20000, 0.25, 7500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newTestTeam()
+			tm.Budget, tm.WageBudget = tt.budget, tt.wageBudget
+			total := tt.budget + tt.wageBudget*weeksPerSeason
+
+			if err := NewFinancialManager(tm).AllocateBudget(tt.transferShare); err != nil {
+				t.Fatal(err)
+			}
+			if got := tm.Budget + tm.WageBudget*weeksPerSeason; got != total {
+				t.Errorf("total = %d after reallocation, want %d", got, total)
+			}
+			if tm.WageBudget != tt.wantWeekly {
+				t.Errorf("WageBudget = %d, want %d", tm.WageBudget, tt.wantWeekly)
+			}
+			if tm.Budget < 0 || tm.WageBudget < 0 {
+				t.Errorf("Budget = %d, WageBudget = %d, want both non-negative", tm.Budget, tm.WageBudget)
+			}
+		})
+	}
+}
+
+func TestAllocateBudgetRoundTrip(t *testing.T) {
+	tm := newTestTeam()
+	tm.Budget, tm.WageBudget = 7800000, 150000
+	fm := NewFinancialManager(tm)
+
+	for _, share := range []float64{0.9, 0.1, 0.5} {
+		if err := fm.AllocateBudget(share); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if tm.Budget != 7800000 || tm.WageBudget != 150000 {
+		t.Errorf("Budget = %d, WageBudget = %d after shifting back, want 7800000 and 150000", tm.Budget, tm.WageBudget)
+	}
+}
+
+func TestAllocateBudgetRejects(t *testing.T) {
+	tests := []struct {
+		name          string
+		budget        int64
+		transferShare float64
+	}{
+		{"negative share", 1000000, -0.1},
+		{"share above one", 1000000, 1.1},
+		{"not a number", 1000000, math.NaN()},
+		{"overdrawn club", -6000000, 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newTestTeam()
+			tm.Budget, tm.WageBudget = tt.budget, 100000
+
+			err := NewFinancialManager(tm).AllocateBudget(tt.transferShare)
+			if !errors.Is(err, common.ErrInvalidBudgetAllocation) {
+				t.Errorf("AllocateBudget(%v) = %v, want ErrInvalidBudgetAllocation", tt.transferShare, err)
+			}
+			if tm.Budget != tt.budget || tm.WageBudget != 100000 {
+				t.Errorf("budgets changed on a rejected allocation: %d, %d", tm.Budget, tm.WageBudget)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@
 package team
 
 import (
+	"math"
+
 	"github.com/devvspaces/fantasy_league/internal/domain/player"
 )
 
@@ -143,3 +145,29 @@ func (f Formation) GetFormationStrength(matchup Formation) float64 {
 
 	return 1.0 // No advantage
 }
+
+// venueFormationPenalty is the strength lost per step of boldness a
+// formation takes against the venue: attacking shapes away from home or
+// defensive shapes at home
+const venueFormationPenalty = 0.03
+
+// GetFormationStrengthAtVenue calculates formation effectiveness adjusted
+// for venue. Bold formations are penalized away from home and defensive
+// ones are rewarded less at home; other setups match GetFormationStrength.
+func (f Formation) GetFormationStrengthAtVenue(matchup Formation, isHome bool) float64 {
+	strength := f.GetFormationStrength(matchup)
+
+	boldness := f.Boldness()
+	if (isHome && boldness < 0) || (!isHome && boldness > 0) {
+		strength *= 1 - venueFormationPenalty*math.Abs(float64(boldness))
+	}
+
+	return strength
+}
+
+// Boldness rates how attacking a formation is relative to a flat 4-4-2:
+// positive with extra forwards or fewer defenders, negative the other way
+func (f Formation) Boldness() int {
+	req := f.GetPositionRequirements()
+	return (req[player.PositionFWD] - 2) + (4 - req[player.PositionDEF])
+}
@@ -0,0 +1,63 @@
+// domain/team/formation_test.go
+package team
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFormationBoldness(t *testing.T) {
+	tests := []struct {
+		formation Formation
+		want      int
+	}{
+		{Formation442, 0},
+		{Formation4312, 0},
+		{Formation433, 1},
+		{Formation352, 1},
+		{Formation451, -1},
+		{Formation532, -1},
+		{Formation4231, -1},
+	}
+
+	for _, tt := range tests {
+		if got := tt.formation.Boldness(); got != tt.want {
+			t.Errorf("%s Boldness() = %d, want %d", tt.formation, got, tt.want)
+		}
+	}
+}
+
+func TestGetFormationStrengthAtVenue(t *testing.T) {
+	tests := []struct {
+		name      string
+		formation Formation
+		matchup   Formation
+		wantHome  float64
+		wantAway  float64
+	}{
+		{"balanced shape ignores venue", Formation442, Formation433, 0.9, 0.9},
+		{"attacking shape penalized away", Formation433, Formation442, 1.1, 1.1 * 0.97},
+		{"defensive shape rewarded less at home", Formation451, Formation433, 1.1 * 0.97, 1.1},
+		{"penalty applies to a poor matchup too", Formation352, Formation532, 0.9, 0.9 * 0.97},
+		{"no matchup edge", Formation4231, Formation442, 0.97, 1.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := tt.formation.GetFormationStrengthAtVenue(tt.matchup, true)
+			away := tt.formation.GetFormationStrengthAtVenue(tt.matchup, false)
+			if math.Abs(home-tt.wantHome) > 1e-9 {
+				t.Errorf("home strength = %.4f, want %.4f", home, tt.wantHome)
+			}
+			if math.Abs(away-tt.wantAway) > 1e-9 {
+				t.Errorf("away strength = %.4f, want %.4f", away, tt.wantAway)
+			}
+
+			// The venue only ever takes away from the pure matchup
+			pure := tt.formation.GetFormationStrength(tt.matchup)
+			if math.Max(home, away) != pure {
+				t.Errorf("better venue strength = %.4f, want the pure matchup %.4f", math.Max(home, away), pure)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// domain/team/momentum.go
+package team
+
+const (
+	// maxMomentum is the largest strength swing from recent form
+	maxMomentum = 0.03
+	// momentumResults is how many recent results carry momentum
+	momentumResults = 5
+)
+
+// GetMomentum returns a strength swing between -0.03 and +0.03 from recent
+// results. Newer results count for more, so one win after a losing run
+// only softens the slump rather than reversing it.
+func (t *Team) GetMomentum() float64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	var score, full float64
+	for i := 0; i < momentumResults; i++ {
+		// Weights fall from 5 for the latest result to 1 for the oldest
+		weight := float64(momentumResults - i)
+		full += weight
+
+		if i >= len(t.CurrentForm) {
+			continue
+		}
+		switch t.CurrentForm[i].Result {
+		case "W":
+			score += weight
+		case "L":
+			score -= weight
+		}
+	}
+
+	// A short history counts for less than a full run of results
+	return score / full * maxMomentum
+}
@@ -0,0 +1,36 @@
+// domain/team/momentum_test.go
+package team
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGetMomentum(t *testing.T) {
+	tests := []struct {
+		name    string
+		results string // Oldest first
+		want    float64
+	}{
+		{"no results", "", 0},
+		{"five wins", "WWWWW", maxMomentum},
+		{"five losses", "LLLLL", -maxMomentum},
+		{"five draws", "DDDDD", 0},
+		{"one win counts for a third", "W", maxMomentum / 3},
+		{"win after a losing run softens the slump", "LLLLW", -maxMomentum / 3},
+		{"old win barely helps", "WLLLL", -maxMomentum * 13 / 15},
+		{"only the last five count", "LWWWWW", maxMomentum},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newTestTeam()
+			for _, r := range tt.results {
+				tm.UpdateForm(MatchResult{Result: string(r)})
+			}
+			if got := tm.GetMomentum(); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("GetMomentum() = %.4f, want %.4f", got, tt.want)
+			}
+		})
+	}
+}
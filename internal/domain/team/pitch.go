@@ -0,0 +1,43 @@
+// domain/team/pitch.go
+package team
+
+import (
+	"strings"
+)
+
+// Pitch surfaces
+const (
+	PitchGrass      = "grass"
+	PitchArtificial = "artificial"
+)
+
+const (
+	// artificialFamiliarity is the edge for a team used to artificial turf
+	artificialFamiliarity = 0.02
+	// artificialUnfamiliarity is the handicap for a team unused to it
+	artificialUnfamiliarity = -0.03
+)
+
+// NormalizePitchType validates a pitch surface, treating anything unknown
+// as grass
+func NormalizePitchType(pitch string) string {
+	switch p := strings.ToLower(strings.TrimSpace(pitch)); p {
+	case PitchArtificial:
+		return p
+	default:
+		return PitchGrass
+	}
+}
+
+// PitchAdvantage returns the strength swing for playing on the venue's
+// surface. Grass is neutral; on artificial turf a team whose own ground
+// is artificial gains an edge while others struggle to adapt.
+func (t *Team) PitchAdvantage(venuePitch string) float64 {
+	if NormalizePitchType(venuePitch) != PitchArtificial {
+		return 0
+	}
+	if NormalizePitchType(t.Stadium.PitchType) == PitchArtificial {
+		return artificialFamiliarity
+	}
+	return artificialUnfamiliarity
+}
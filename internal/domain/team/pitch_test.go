@@ -0,0 +1,56 @@
+// domain/team/pitch_test.go
+package team
+
+import "testing"
+
+func TestNormalizePitchType(t *testing.T) {
+	tests := []struct {
+		pitch string
+		want  string
+	}{
+		{"grass", PitchGrass},
+		{"artificial", PitchArtificial},
+		{" Artificial ", PitchArtificial},
+		{"ARTIFICIAL", PitchArtificial},
+		{"Grass", PitchGrass},
+		{"hybrid", PitchGrass},
+		{"", PitchGrass},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pitch, func(t *testing.T) {
+			if got := NormalizePitchType(tt.pitch); got != tt.want {
+				t.Errorf("NormalizePitchType(%q) = %q, want %q", tt.pitch, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPitchAdvantage(t *testing.T) {
+	tests := []struct {
+		name       string
+		ownPitch   string
+		venuePitch string
+		want       float64
+	}{
+		{"grass side on grass", PitchGrass, PitchGrass, 0},
+		{"artificial side on grass", PitchArtificial, PitchGrass, 0},
+		{"home on its own artificial pitch", PitchArtificial, PitchArtificial, artificialFamiliarity},
+		{"grass side visiting artificial turf", PitchGrass, PitchArtificial, artificialUnfamiliarity},
+		{"unknown home surface counts as grass", "", "Artificial", artificialUnfamiliarity},
+		{"unknown venue surface counts as grass", PitchGrass, "hybrid", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newTestTeam()
+			tm.Stadium.PitchType = tt.ownPitch
+			if got := tm.PitchAdvantage(tt.venuePitch); got != tt.want {
+				t.Errorf("PitchAdvantage(%q) = %v, want %v", tt.venuePitch, got, tt.want)
+			}
+		})
+	}
+	if artificialFamiliarity <= 0 || artificialUnfamiliarity >= 0 {
+		t.Errorf("familiarity %v, unfamiliarity %v: want an edge and a penalty", artificialFamiliarity, artificialUnfamiliarity)
+	}
+}
@@ -0,0 +1,69 @@
+// domain/team/presets.go
+package team
+
+// TacticsPreset names a ready-made tactical style
+type TacticsPreset string
+
+const (
+	PresetDefensive  TacticsPreset = "defensive"  // Park the bus
+	PresetBalanced   TacticsPreset = "balanced"   // Default setup
+	PresetAttacking  TacticsPreset = "attacking"  // Commit bodies forward
+	PresetHighPress  TacticsPreset = "high_press" // Gegenpress
+	PresetPossession TacticsPreset = "possession" // Tiki-taka
+)
+
+// TacticsPresets returns every preset in order from most defensive to most
+// expansive
+func TacticsPresets() []TacticsPreset {
+	return []TacticsPreset{
+		PresetDefensive,
+		PresetBalanced,
+		PresetPossession,
+		PresetAttacking,
+		PresetHighPress,
+	}
+}
+
+// PresetTactics returns the full tactical instructions for a preset.
+// Unknown presets get the default tactics.
+func PresetTactics(p TacticsPreset) TeamTactics {
+	switch p {
+	case PresetDefensive:
+		// Deep, narrow block that sits off and plays slowly
+		return TeamTactics{
+			Mentality:     MentalityDefensive,
+			Tempo:         3,
+			Pressing:      2,
+			DefensiveLine: 2,
+			Width:         3,
+		}
+	case PresetAttacking:
+		return TeamTactics{
+			Mentality:     MentalityAttacking,
+			Tempo:         7,
+			Pressing:      6,
+			DefensiveLine: 7,
+			Width:         7,
+		}
+	case PresetHighPress:
+		// Win the ball back high and attack quickly
+		return TeamTactics{
+			Mentality:     MentalityAttacking,
+			Tempo:         9,
+			Pressing:      9,
+			DefensiveLine: 8,
+			Width:         6,
+		}
+	case PresetPossession:
+		// Patient short passing, pressing to recover the ball
+		return TeamTactics{
+			Mentality:     MentalityBalanced,
+			Tempo:         3,
+			Pressing:      7,
+			DefensiveLine: 7,
+			Width:         8,
+		}
+	default:
+		return DefaultTactics()
+	}
+}
@@ -0,0 +1,67 @@
+// domain/team/presets_test.go
+package team
+
+import "testing"
+
+func TestPresetTacticsValidate(t *testing.T) {
+	seen := map[TeamTactics]TacticsPreset{}
+	for _, preset := range TacticsPresets() {
+		t.Run(string(preset), func(t *testing.T) {
+			tactics := PresetTactics(preset)
+			if err := tactics.Validate(); err != nil {
+				t.Errorf("PresetTactics(%s).Validate() = %v", preset, err)
+			}
+			if other, ok := seen[tactics]; ok {
+				t.Errorf("%s has the same tactics as %s", preset, other)
+			}
+			seen[tactics] = preset
+		})
+	}
+}
+
+func TestPresetTacticsDefaults(t *testing.T) {
+	tests := []struct {
+		name   string
+		preset TacticsPreset
+	}{
+		{"balanced", PresetBalanced},
+		{"unknown", TacticsPreset("route_one")},
+		{"empty", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PresetTactics(tt.preset); got != DefaultTactics() {
+				t.Errorf("PresetTactics(%q) = %+v, want the default tactics", tt.preset, got)
+			}
+		})
+	}
+}
+
+func TestPresetTacticsStyles(t *testing.T) {
+	defensive := PresetTactics(PresetDefensive)
+	balanced := PresetTactics(PresetBalanced)
+	attacking := PresetTactics(PresetAttacking)
+	highPress := PresetTactics(PresetHighPress)
+	possession := PresetTactics(PresetPossession)
+
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{"defensive sits deeper than balanced",
+			defensive.DefensiveLine < balanced.DefensiveLine && defensive.Mentality == MentalityDefensive},
+		{"attacking pushes higher than balanced",
+			attacking.DefensiveLine > balanced.DefensiveLine && attacking.Mentality == MentalityAttacking},
+		{"high press presses hardest", highPress.Pressing > attacking.Pressing && highPress.Pressing > possession.Pressing},
+		{"possession slows the tempo", possession.Tempo < balanced.Tempo && possession.Width > balanced.Width},
+		{"park the bus conserves energy", defensive.FatigueMultiplier() < 1},
+		{"pressing high is the most tiring", highPress.FatigueMultiplier() > attacking.FatigueMultiplier()},
+	}
+
+	for _, tt := range tests {
+		if !tt.ok {
+			t.Errorf("preset style broken: %s", tt.name)
+		}
+	}
+}
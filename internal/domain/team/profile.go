@@ -0,0 +1,38 @@
+// domain/team/profile.go
+package team
+
+import (
+	"math"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// AggregateAttributes averages the skill attributes of the team's best
+// eleven, giving a scout or AI opponent the team's technical and physical
+// profile at a glance. Quality is the starters' average overall rating;
+// hidden attributes are left at zero.
+func (t *Team) AggregateAttributes() player.Attributes {
+	var aggregate player.Attributes
+
+	starters := t.GetBestEleven()
+	if len(starters) == 0 {
+		return aggregate
+	}
+
+	totals := make(map[string]int)
+	quality := 0
+	for i := range starters {
+		starters[i].Attributes.ForEach(func(name string, value int) {
+			totals[name] += value
+		})
+		quality += starters[i].GetOverallRating()
+	}
+
+	count := float64(len(starters))
+	for _, name := range player.AttributeNames() {
+		aggregate.Set(name, int(math.Round(float64(totals[name])/count)))
+	}
+	aggregate.Quality = int(math.Round(float64(quality) / count))
+
+	return aggregate
+}
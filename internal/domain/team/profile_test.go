@@ -0,0 +1,76 @@
+// domain/team/profile_test.go
+package team
+
+import (
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+func TestGetBestElevenPicksEachPlayerOnce(t *testing.T) {
+	tests := []struct {
+		name   string
+		counts map[player.Position]int
+	}{
+		// Midfielders can also cover defence and attack, so a selection
+		// without a used set would pick the best of them several times
+		{"surplus midfielders", map[player.Position]int{
+			player.PositionGK: 1, player.PositionDEF: 2, player.PositionMID: 10, player.PositionFWD: 1,
+		}},
+		// Taking the best midfielders for defence first must still leave
+		// enough to cover midfield and attack
+		{"exact cover", map[player.Position]int{
+			player.PositionGK: 1, player.PositionDEF: 3, player.PositionMID: 6, player.PositionFWD: 1,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newTestTeam()
+			addTestSquad(t, tm, tt.counts)
+			tm.UpdatePlayers(func(p *player.Player) {
+				if p.Position == player.PositionMID {
+					p.Attributes.Passing, p.Attributes.Perception, p.Attributes.BallControl = 99, 99, 99
+				}
+			})
+
+			eleven := tm.GetBestEleven()
+			if len(eleven) != 11 {
+				t.Fatalf("len(GetBestEleven()) = %d, want 11", len(eleven))
+			}
+			seen := make(map[player.PlayerID]bool)
+			for _, p := range eleven {
+				if seen[p.ID] {
+					t.Errorf("player %s picked more than once", p.ID)
+				}
+				seen[p.ID] = true
+			}
+			if eleven[0].Position != player.PositionGK {
+				t.Errorf("first pick is %s, want the goalkeeper", eleven[0].Position)
+			}
+		})
+	}
+}
+
+func TestAggregateAttributes(t *testing.T) {
+	tm := newTestTeam()
+	if got := tm.AggregateAttributes(); got != (player.Attributes{}) {
+		t.Errorf("empty squad aggregate = %+v, want zero", got)
+	}
+
+	addTestSquad(t, tm, map[player.Position]int{
+		player.PositionGK:  1,
+		player.PositionDEF: 4,
+		player.PositionMID: 4,
+		player.PositionFWD: 2,
+	})
+	tm.UpdatePlayers(func(p *player.Player) { p.Attributes.Speed = 60 })
+	if err := tm.UpdatePlayer("FWD0", func(p *player.Player) { p.Attributes.Speed = 82 }); err != nil {
+		t.Fatal(err)
+	}
+
+	// (10*60 + 82) / 11 = 62
+	if got := tm.AggregateAttributes().Speed; got != 62 {
+		t.Errorf("aggregate Speed = %d, want 62", got)
+	}
+}
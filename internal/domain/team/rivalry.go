@@ -0,0 +1,38 @@
+// domain/team/rivalry.go
+package team
+
+import (
+	"time"
+)
+
+// AddRival records a derby opponent, ignoring duplicates and the team itself
+func (t *Team) AddRival(opponent TeamID) {
+	if opponent == t.ID {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, rival := range t.Rivals {
+		if rival == opponent {
+			return
+		}
+	}
+
+	t.Rivals = append(t.Rivals, opponent)
+	t.UpdatedAt = time.Now()
+}
+
+// IsRivalWith checks if a match against the opponent is a derby
+func (t *Team) IsRivalWith(opponent string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	for _, rival := range t.Rivals {
+		if string(rival) == opponent {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+// domain/team/rivalry_test.go
+package team
+
+import "testing"
+
+func TestAddRival(t *testing.T) {
+	tm := newTestTeam()
+	tm.AddRival("city")
+	tm.AddRival("city")
+	tm.AddRival(tm.ID)
+
+	tests := []struct {
+		opponent string
+		want     bool
+	}{
+		{"city", true},
+		{"town", false},
+		{string(tm.ID), false},
+	}
+	for _, tt := range tests {
+		if got := tm.IsRivalWith(tt.opponent); got != tt.want {
+			t.Errorf("IsRivalWith(%s) = %v, want %v", tt.opponent, got, tt.want)
+		}
+	}
+	if len(tm.Rivals) != 1 {
+		t.Errorf("Rivals = %v, want only city", tm.Rivals)
+	}
+}
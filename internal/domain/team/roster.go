@@ -0,0 +1,177 @@
+// domain/team/roster.go
+package team
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// rosterHeader defines the column order of exported squads. The name column
+// is the display name; the name parts are exported separately so a
+// nickname survives a round trip.
+var rosterHeader = []string{
+	"id", "name", "first_name", "last_name", "nickname", "age", "position",
+	"overall", "fitness", "morale", "value", "wage",
+}
+
+// requiredRosterColumns must be present when importing a squad, along with
+// either name or first_name
+var requiredRosterColumns = []string{"id", "age", "position"}
+
+// ExportSquadCSV writes the squad as CSV, one row per player
+func (sm *SquadManager) ExportSquadCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(rosterHeader); err != nil {
+		return err
+	}
+
+	for _, p := range sm.team.players() {
+		row := []string{
+			string(p.ID),
+			p.FullName(),
+			p.FirstName,
+			p.LastName,
+			p.Nickname,
+			strconv.Itoa(p.Age()),
+			string(p.Position),
+			strconv.Itoa(p.GetOverallRating()),
+			strconv.FormatFloat(p.Fitness, 'f', 1, 64),
+			strconv.FormatFloat(p.Morale, 'f', 1, 64),
+			strconv.FormatInt(p.MarketValue, 10),
+			strconv.FormatInt(p.Wage, 10),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ImportSquadCSV reads players from CSV produced by ExportSquadCSV
+func ImportSquadCSV(r io.Reader) ([]player.Player, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("roster is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Map column names to indexes
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range requiredRosterColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("roster missing required column %q", name)
+		}
+	}
+	_, hasName := columns["name"]
+	_, hasFirstName := columns["first_name"]
+	if !hasName && !hasFirstName {
+		return nil, fmt.Errorf("roster missing required column %q", "name")
+	}
+
+	players := []player.Player{}
+	line := 1
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line++
+
+		p, err := parseRosterRow(record, columns)
+		if err != nil {
+			return nil, fmt.Errorf("roster line %d: %w", line, err)
+		}
+		players = append(players, *p)
+	}
+
+	return players, nil
+}
+
+// parseRosterRow builds a player from a single CSV record
+func parseRosterRow(record []string, columns map[string]int) (*player.Player, error) {
+	field := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	id := field("id")
+	if id == "" {
+		return nil, fmt.Errorf("missing player id")
+	}
+
+	// Prefer the name parts, falling back to splitting the display name
+	firstName, lastName := field("first_name"), field("last_name")
+	if firstName == "" && lastName == "" {
+		name := field("name")
+		if name == "" {
+			return nil, fmt.Errorf("missing player name")
+		}
+		firstName = name
+		if i := strings.LastIndex(name, " "); i > 0 {
+			firstName, lastName = name[:i], name[i+1:]
+		}
+	}
+
+	position := player.Position(strings.ToUpper(field("position")))
+	switch position {
+	case player.PositionGK, player.PositionDEF, player.PositionMID, player.PositionFWD:
+	default:
+		return nil, fmt.Errorf("invalid position %q", field("position"))
+	}
+
+	age, err := strconv.Atoi(field("age"))
+	if err != nil || age < 0 {
+		return nil, fmt.Errorf("invalid age %q", field("age"))
+	}
+
+	// Age is all we know, so approximate the birth date
+	dob := time.Now().AddDate(-age, 0, -1)
+	p := player.NewPlayer(player.PlayerID(id), firstName, lastName, position, dob)
+	p.Nickname = field("nickname")
+
+	// Optional columns
+	if v := field("fitness"); v != "" {
+		if p.Fitness, err = strconv.ParseFloat(v, 64); err != nil {
+			return nil, fmt.Errorf("invalid fitness %q", v)
+		}
+	}
+	if v := field("morale"); v != "" {
+		if p.Morale, err = strconv.ParseFloat(v, 64); err != nil {
+			return nil, fmt.Errorf("invalid morale %q", v)
+		}
+	}
+	if v := field("value"); v != "" {
+		if p.MarketValue, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid value %q", v)
+		}
+	}
+	if v := field("wage"); v != "" {
+		if p.Wage, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, fmt.Errorf("invalid wage %q", v)
+		}
+	}
+
+	return p, nil
+}
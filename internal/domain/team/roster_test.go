@@ -0,0 +1,110 @@
+// domain/team/roster_test.go
+package team
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+func TestRosterRoundTrip(t *testing.T) {
+	tm := newTestTeam()
+
+	nicknamed := newTestPlayer("p1", player.PositionFWD, 27)
+	nicknamed.FirstName, nicknamed.LastName, nicknamed.Nickname = "Edson Arantes", "do Nascimento", "Pelé"
+	nicknamed.Fitness, nicknamed.Morale = 88.5, 64
+	nicknamed.MarketValue, nicknamed.Wage = 12000000, 45000
+
+	plain := newTestPlayer("p2", player.PositionGK, 31)
+	plain.FirstName, plain.LastName = "Gianluigi", "Buffon"
+
+	for _, p := range []player.Player{nicknamed, plain} {
+		if err := tm.AddPlayer(p); err != nil {
+			t.Fatalf("AddPlayer(%s): %v", p.ID, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := NewSquadManager(tm).ExportSquadCSV(&buf); err != nil {
+		t.Fatalf("ExportSquadCSV: %v", err)
+	}
+	imported, err := ImportSquadCSV(&buf)
+	if err != nil {
+		t.Fatalf("ImportSquadCSV: %v", err)
+	}
+	if len(imported) != 2 {
+		t.Fatalf("imported %d players, want 2", len(imported))
+	}
+
+	for i, want := range []player.Player{nicknamed, plain} {
+		got := imported[i]
+		if got.ID != want.ID || got.FirstName != want.FirstName || got.LastName != want.LastName || got.Nickname != want.Nickname {
+			t.Errorf("player %d identity = %q %q %q %q, want %q %q %q %q", i,
+				got.ID, got.FirstName, got.LastName, got.Nickname,
+				want.ID, want.FirstName, want.LastName, want.Nickname)
+		}
+		if got.Position != want.Position || got.Age() != want.Age() {
+			t.Errorf("player %d = %s aged %d, want %s aged %d", i, got.Position, got.Age(), want.Position, want.Age())
+		}
+		if got.Fitness != want.Fitness || got.Morale != want.Morale || got.MarketValue != want.MarketValue || got.Wage != want.Wage {
+			t.Errorf("player %d condition or finances differ: got %+v", i, got)
+		}
+	}
+}
+
+func TestImportSquadCSV(t *testing.T) {
+	tests := []struct {
+		name      string
+		csv       string
+		wantErr   string
+		wantFirst string
+		wantLast  string
+	}{
+		{
+			name:      "legacy name column is split",
+			csv:       "id,name,age,position\np1,Kevin De Bruyne,30,MID\n",
+			wantFirst: "Kevin De",
+			wantLast:  "Bruyne",
+		},
+		{
+			name:      "name parts preferred",
+			csv:       "id,name,first_name,last_name,age,position\np1,Kaka,Ricardo,Izecson,30,MID\n",
+			wantFirst: "Ricardo",
+			wantLast:  "Izecson",
+		},
+		{
+			name:      "single word name",
+			csv:       "id,name,age,position\np1,Hulk,30,FWD\n",
+			wantFirst: "Hulk",
+		},
+		{name: "missing name column", csv: "id,age,position\np1,30,MID\n", wantErr: `missing required column "name"`},
+		{name: "missing id column", csv: "name,age,position\nA B,30,MID\n", wantErr: `missing required column "id"`},
+		{name: "blank name", csv: "id,name,age,position\np1,,30,MID\n", wantErr: "missing player name"},
+		{name: "bad position", csv: "id,name,age,position\np1,A B,30,WING\n", wantErr: "invalid position"},
+		{name: "bad age", csv: "id,name,age,position\np1,A B,old,MID\n", wantErr: "invalid age"},
+		{name: "empty", csv: "", wantErr: "roster is empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			players, err := ImportSquadCSV(strings.NewReader(tt.csv))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want one containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ImportSquadCSV: %v", err)
+			}
+			if len(players) != 1 {
+				t.Fatalf("imported %d players, want 1", len(players))
+			}
+			if p := players[0]; p.FirstName != tt.wantFirst || p.LastName != tt.wantLast {
+				t.Errorf("name = %q %q, want %q %q", p.FirstName, p.LastName, tt.wantFirst, tt.wantLast)
+			}
+		})
+	}
+}
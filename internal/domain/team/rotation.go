@@ -0,0 +1,205 @@
+// domain/team/rotation.go
+package team
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+const (
+	// selectionFitness is the projected fitness needed to be picked
+	selectionFitness = 70.0
+	// minRotationPool is the number of fit players needed to rotate properly
+	minRotationPool = 16
+	// rotationTrainingIntensity is the assumed training load between matches
+	rotationTrainingIntensity = 0.5
+	// freshnessWeight trades ability against projected fitness
+	freshnessWeight = 0.6
+	// congestionWindow is the period over which recent starts are counted
+	congestionWindow = 7 * 24 * time.Hour
+	// congestionPenalty reduces a player's score for each recent start
+	congestionPenalty = 0.15
+)
+
+// ScheduledMatch is an upcoming match the squad must be picked for
+type ScheduledMatch struct {
+	ID   string
+	Date time.Time
+}
+
+// RotationPlan holds suggested lineups for a run of fixtures
+type RotationPlan struct {
+	Lineups      map[string]Lineup
+	ThinFixtures []string // Matches with too few fit players to rotate
+}
+
+// PlanRotation suggests a lineup for each upcoming match, projecting
+// fatigue and recovery and spreading starts across congested spells so key
+// players stay fresh. The plan depends only on the squad's current state
+// and the match dates.
+func (sm *SquadManager) PlanRotation(matches []ScheduledMatch, formation Formation) (RotationPlan, error) {
+	plan := RotationPlan{Lineups: make(map[string]Lineup)}
+	if !formation.IsValid() {
+		return plan, common.InvalidFormation(string(formation))
+	}
+
+	ordered := append([]ScheduledMatch(nil), matches...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Date.Before(ordered[j].Date)
+	})
+
+	fm := player.NewFitnessManager()
+	squad := sm.team.players()
+	projected := make(map[player.PlayerID]float64, len(squad))
+	for _, p := range squad {
+		projected[p.ID] = p.Fitness
+	}
+	starts := make(map[player.PlayerID][]time.Time)
+
+	for i, m := range ordered {
+		if i > 0 {
+			days := int(m.Date.Sub(ordered[i-1].Date).Hours() / 24)
+			sm.projectRecovery(fm, projected, days)
+		}
+
+		eligible := sm.rotationCandidates(projected)
+		if len(eligible) < minRotationPool {
+			plan.ThinFixtures = append(plan.ThinFixtures, m.ID)
+		}
+
+		load := make(map[player.PlayerID]int)
+		for id, dates := range starts {
+			for _, d := range dates {
+				if m.Date.Sub(d) < congestionWindow {
+					load[id]++
+				}
+			}
+		}
+
+		lineup, err := sm.pickRotatedLineup(eligible, load, formation)
+		if err != nil {
+			details := map[string]interface{}{"match_id": m.ID}
+			if errors.Is(err, common.ErrInsufficientPlayers) {
+				return plan, common.ErrInsufficientPlayers.WithDetails(details)
+			}
+			return plan, common.ErrInsufficientPlayers.Wrap(err).WithDetails(details)
+		}
+		plan.Lineups[m.ID] = *lineup
+
+		for _, id := range lineup.Starters {
+			p, _ := sm.team.GetPlayer(id)
+			p.Fitness = projected[id]
+			projected[id] = fm.FitnessAtMinute(p, 90, 1.0)
+			starts[id] = append(starts[id], m.Date)
+		}
+	}
+
+	return plan, nil
+}
+
+// projectRecovery applies daily recovery to the projected fitness levels
+func (sm *SquadManager) projectRecovery(fm *player.FitnessManager, projected map[player.PlayerID]float64, days int) {
+	for _, p := range sm.team.players() {
+		p.Fitness = projected[p.ID]
+		for d := 0; d < days; d++ {
+			fm.ApplyDailyRecovery(&p, rotationTrainingIntensity)
+		}
+		projected[p.ID] = p.Fitness
+	}
+}
+
+// rotationCandidates returns players fit enough to be selected, with their
+// projected fitness applied
+func (sm *SquadManager) rotationCandidates(projected map[player.PlayerID]float64) []player.Player {
+	candidates := []player.Player{}
+	for _, p := range sm.team.players() {
+		p.Fitness = projected[p.ID]
+		if p.Status == player.StatusAvailable && p.Fitness >= selectionFitness {
+			candidates = append(candidates, p)
+		}
+	}
+	return candidates
+}
+
+// pickRotatedLineup fills each position with the best rested players,
+// using specialists before players covering from another position
+func (sm *SquadManager) pickRotatedLineup(candidates []player.Player, load map[player.PlayerID]int, formation Formation) (*Lineup, error) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		si := rotationScore(candidates[i], load[candidates[i].ID])
+		sj := rotationScore(candidates[j], load[candidates[j].ID])
+		if si != sj {
+			return si > sj
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+
+	requirements := formation.GetPositionRequirements()
+	positions := []player.Position{
+		player.PositionGK,
+		player.PositionDEF,
+		player.PositionMID,
+		player.PositionFWD,
+	}
+
+	used := make(map[player.PlayerID]bool)
+	picked := make(map[player.Position][]player.PlayerID)
+
+	for _, specialistsOnly := range []bool{true, false} {
+		for _, pos := range positions {
+			for _, p := range candidates {
+				if len(picked[pos]) >= requirements[pos] {
+					break
+				}
+				if used[p.ID] || !p.CanPlayPosition(pos) {
+					continue
+				}
+				if specialistsOnly && p.Position != pos {
+					continue
+				}
+				picked[pos] = append(picked[pos], p.ID)
+				used[p.ID] = true
+			}
+		}
+	}
+
+	lineup := &Lineup{
+		Formation:   formation,
+		Starters:    []player.PlayerID{},
+		Positions:   []player.Position{},
+		Substitutes: []player.PlayerID{},
+	}
+	for _, pos := range positions {
+		for _, id := range picked[pos] {
+			lineup.Starters = append(lineup.Starters, id)
+			lineup.Positions = append(lineup.Positions, pos)
+		}
+	}
+	if len(lineup.Starters) < 11 {
+		return nil, common.ErrInsufficientPlayers
+	}
+
+	for _, p := range candidates {
+		if !used[p.ID] && len(lineup.Substitutes) < 7 {
+			lineup.Substitutes = append(lineup.Substitutes, p.ID)
+		}
+	}
+
+	if captain := sm.selectCaptain(lineup.Starters); captain != nil {
+		lineup.Captain = *captain
+	}
+
+	return lineup, nil
+}
+
+// rotationScore weighs a player's ability against how rested they are and
+// how many matches they have started recently
+func rotationScore(p player.Player, recentStarts int) float64 {
+	freshness := math.Max(0, p.Fitness-selectionFitness) / (100 - selectionFitness)
+	score := float64(p.GetOverallRating()) * (1 - freshnessWeight + freshnessWeight*freshness)
+	return score * math.Max(0, 1-congestionPenalty*float64(recentStarts))
+}
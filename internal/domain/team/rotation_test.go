@@ -0,0 +1,104 @@
+// domain/team/rotation_test.go
+package team
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// congestedRun returns matches every three days
+func congestedRun(n int) []ScheduledMatch {
+	start := time.Date(2026, 8, 1, 15, 0, 0, 0, time.UTC)
+	matches := make([]ScheduledMatch, n)
+	for i := range matches {
+		matches[i] = ScheduledMatch{
+			ID:   string(rune('a' + i)),
+			Date: start.AddDate(0, 0, 3*i),
+		}
+	}
+	return matches
+}
+
+func TestPlanRotationLineupsAreValid(t *testing.T) {
+	tm := newTestTeam()
+	addTestSquad(t, tm, map[player.Position]int{
+		player.PositionGK:  2,
+		player.PositionDEF: 7,
+		player.PositionMID: 7,
+		player.PositionFWD: 4,
+	})
+	matches := congestedRun(5)
+
+	plan, err := NewSquadManager(tm).PlanRotation(matches, Formation442)
+	if err != nil {
+		t.Fatalf("PlanRotation: %v", err)
+	}
+	if len(plan.ThinFixtures) != 0 {
+		t.Errorf("ThinFixtures = %v with a 20-player squad, want none", plan.ThinFixtures)
+	}
+	for _, m := range matches {
+		lineup, ok := plan.Lineups[m.ID]
+		if !ok {
+			t.Errorf("no lineup for match %s", m.ID)
+			continue
+		}
+		if err := tm.ValidateLineup(lineup); err != nil {
+			t.Errorf("match %s: ValidateLineup() = %v", m.ID, err)
+		}
+	}
+
+	again, err := NewSquadManager(tm).PlanRotation(matches, Formation442)
+	if err != nil {
+		t.Fatalf("PlanRotation: %v", err)
+	}
+	if !reflect.DeepEqual(plan, again) {
+		t.Errorf("plans differ between runs:\n%+v\n%+v", plan, again)
+	}
+}
+
+func TestPlanRotationThinSquad(t *testing.T) {
+	tm := newTestTeam()
+	addTestSquad(t, tm, map[player.Position]int{
+		player.PositionGK:  1,
+		player.PositionDEF: 5,
+		player.PositionMID: 4,
+		player.PositionFWD: 3,
+	})
+	matches := congestedRun(3)
+
+	plan, err := NewSquadManager(tm).PlanRotation(matches, Formation442)
+	if err != nil {
+		t.Fatalf("PlanRotation: %v", err)
+	}
+	want := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(plan.ThinFixtures, want) {
+		t.Errorf("ThinFixtures = %v, want %v", plan.ThinFixtures, want)
+	}
+}
+
+func TestPlanRotationTooFewPlayers(t *testing.T) {
+	tm := newTestTeam()
+	addTestSquad(t, tm, map[player.Position]int{
+		player.PositionGK:  1,
+		player.PositionDEF: 4,
+		player.PositionMID: 4,
+		player.PositionFWD: 1,
+	})
+
+	_, err := NewSquadManager(tm).PlanRotation(congestedRun(1), Formation442)
+	var domainErr common.DomainError
+	if !errors.As(err, &domainErr) || !errors.Is(err, common.ErrInsufficientPlayers) {
+		t.Fatalf("PlanRotation() = %v, want ErrInsufficientPlayers", err)
+	}
+	if domainErr.Cause != nil {
+		t.Errorf("Cause = %v, want the shortage reported directly", domainErr.Cause)
+	}
+	if domainErr.Details["match_id"] != "a" {
+		t.Errorf("match_id = %v, want a", domainErr.Details["match_id"])
+	}
+}
@@ -0,0 +1,83 @@
+// domain/team/rules.go
+package team
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// defaultMaxSquadSize is the squad limit when no rules are configured
+const defaultMaxSquadSize = 30
+
+// SquadRules sets the registration limits a squad must respect
+type SquadRules struct {
+	MaxSquadSize   int
+	MaxPerPosition map[player.Position]int // Missing or zero means no limit
+	MinGoalkeepers int                     // Squad places held back for keepers
+}
+
+// DefaultSquadRules returns the standard 30-player limit with no
+// positional restrictions
+func DefaultSquadRules() SquadRules {
+	return SquadRules{MaxSquadSize: defaultMaxSquadSize}
+}
+
+// squadRules returns the team's rules, falling back to the defaults for a
+// team built without any
+func (t *Team) squadRules() SquadRules {
+	if t.Rules.MaxSquadSize <= 0 {
+		rules := t.Rules
+		rules.MaxSquadSize = defaultMaxSquadSize
+		return rules
+	}
+	return t.Rules
+}
+
+// checkSquadRules verifies a player can join the squad. The caller must
+// hold the team lock.
+func (t *Team) checkSquadRules(p player.Player) error {
+	rules := t.squadRules()
+
+	if len(t.Players) >= rules.MaxSquadSize {
+		return common.ErrSquadSizeLimit.WithDetails(map[string]interface{}{
+			"max_squad_size": rules.MaxSquadSize,
+		})
+	}
+
+	counts := make(map[player.Position]int)
+	for _, existing := range t.Players {
+		counts[existing.Position]++
+	}
+
+	if limit := rules.MaxPerPosition[p.Position]; limit > 0 && counts[p.Position] >= limit {
+		return common.ErrPositionLimit.WithDetails(map[string]interface{}{
+			"position": string(p.Position),
+			"limit":    limit,
+		})
+	}
+
+	// Keep enough places free to still register the minimum keepers
+	if p.Position != player.PositionGK {
+		missing := rules.MinGoalkeepers - counts[player.PositionGK]
+		if missing > 0 && rules.MaxSquadSize-len(t.Players)-1 < missing {
+			return common.ErrGoalkeeperQuota.WithDetails(map[string]interface{}{
+				"min_goalkeepers": rules.MinGoalkeepers,
+				"goalkeepers":     counts[player.PositionGK],
+			})
+		}
+	}
+
+	return nil
+}
+
+// copySquadRules copies rules without sharing the position limits
+func copySquadRules(rules SquadRules) SquadRules {
+	if rules.MaxPerPosition != nil {
+		limits := make(map[player.Position]int, len(rules.MaxPerPosition))
+		for pos, limit := range rules.MaxPerPosition {
+			limits[pos] = limit
+		}
+		rules.MaxPerPosition = limits
+	}
+	return rules
+}
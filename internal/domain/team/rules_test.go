@@ -0,0 +1,90 @@
+// domain/team/rules_test.go
+package team
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+func TestAddPlayerSquadRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   SquadRules
+		squad   map[player.Position]int
+		adding  player.Position
+		wantErr error
+	}{
+		{"default limit has room", SquadRules{}, map[player.Position]int{player.PositionMID: 29}, player.PositionMID, nil},
+		{"default limit is 30", SquadRules{}, map[player.Position]int{player.PositionMID: 30}, player.PositionMID, common.ErrSquadSizeLimit},
+		{"custom squad size", SquadRules{MaxSquadSize: 18}, map[player.Position]int{player.PositionMID: 18}, player.PositionGK, common.ErrSquadSizeLimit},
+		{
+			"too many forwards",
+			SquadRules{MaxSquadSize: 25, MaxPerPosition: map[player.Position]int{player.PositionFWD: 4}},
+			map[player.Position]int{player.PositionFWD: 4},
+			player.PositionFWD,
+			common.ErrPositionLimit,
+		},
+		{
+			"position cap leaves other positions free",
+			SquadRules{MaxSquadSize: 25, MaxPerPosition: map[player.Position]int{player.PositionFWD: 4}},
+			map[player.Position]int{player.PositionFWD: 4},
+			player.PositionMID,
+			nil,
+		},
+		{
+			"zero cap means no limit",
+			SquadRules{MaxSquadSize: 25, MaxPerPosition: map[player.Position]int{player.PositionFWD: 0}},
+			map[player.Position]int{player.PositionFWD: 10},
+			player.PositionFWD,
+			nil,
+		},
+		{
+			"last places held for keepers",
+			SquadRules{MaxSquadSize: 10, MinGoalkeepers: 2},
+			map[player.Position]int{player.PositionGK: 1, player.PositionDEF: 8},
+			player.PositionDEF,
+			common.ErrGoalkeeperQuota,
+		},
+		{
+			"keeper takes a held place",
+			SquadRules{MaxSquadSize: 10, MinGoalkeepers: 2},
+			map[player.Position]int{player.PositionGK: 1, player.PositionDEF: 8},
+			player.PositionGK,
+			nil,
+		},
+		{
+			"quota met frees the places",
+			SquadRules{MaxSquadSize: 10, MinGoalkeepers: 2},
+			map[player.Position]int{player.PositionGK: 2, player.PositionDEF: 7},
+			player.PositionDEF,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newTestTeam()
+			tm.Rules = SquadRules{MaxSquadSize: 1000}
+			addTestSquad(t, tm, tt.squad)
+			tm.Rules = tt.rules
+
+			before := tm.PlayerCount()
+			err := tm.AddPlayer(newTestPlayer("new", tt.adding, 25))
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("AddPlayer: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("AddPlayer = %v, want %v", err, tt.wantErr)
+			}
+			if got := tm.PlayerCount(); got != before {
+				t.Errorf("PlayerCount() = %d after a rejected signing, want %d", got, before)
+			}
+		})
+	}
+}
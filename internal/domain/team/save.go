@@ -0,0 +1,54 @@
+// domain/team/save.go
+package team
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SaveVersion is the current save format version. Bump it whenever the
+// saved team layout changes and add a migration for older saves.
+const SaveVersion = 1
+
+// saveEnvelope wraps a saved team with its format version
+type saveEnvelope struct {
+	Version int             `json:"version"`
+	Team    json.RawMessage `json:"team"`
+}
+
+// MarshalSave serializes the full team, including its squad, tactics,
+// finances and form, into a versioned save
+func (t *Team) MarshalSave() ([]byte, error) {
+	payload, err := json.Marshal(t.Snapshot())
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(saveEnvelope{
+		Version: SaveVersion,
+		Team:    payload,
+	})
+}
+
+// UnmarshalSave rebuilds a team from a save. Saves written by a newer
+// version of the format are rejected rather than partially loaded.
+func UnmarshalSave(data []byte) (*Team, error) {
+	var envelope saveEnvelope
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("invalid save: %w", err)
+	}
+
+	switch {
+	case envelope.Version <= 0:
+		return nil, fmt.Errorf("save has no format version")
+	case envelope.Version > SaveVersion:
+		return nil, fmt.Errorf("save format version %d is newer than supported version %d", envelope.Version, SaveVersion)
+	}
+
+	var snapshot TeamSnapshot
+	if err := json.Unmarshal(envelope.Team, &snapshot); err != nil {
+		return nil, fmt.Errorf("invalid save team: %w", err)
+	}
+
+	return RestoreTeam(snapshot), nil
+}
@@ -0,0 +1,145 @@
+// domain/team/save_test.go
+package team
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite golden files")
+
+// goldenTeam builds a team whose save is byte-for-byte reproducible
+func goldenTeam(t *testing.T) *Team {
+	t.Helper()
+	at := time.Date(2024, 8, 1, 12, 0, 0, 0, time.UTC)
+
+	tm := NewTeam("golden", "Golden City", Stadium{Name: "Golden Park", Capacity: 42000, City: "Golden", Country: "England", PitchType: "grass"})
+	tm.Founded = 1888
+	tm.ManagerName = "A. Manager"
+	tm.Budget, tm.WageBudget = 25000000, 400000
+
+	squad := []struct {
+		id        string
+		first     string
+		last      string
+		nickname  string
+		pos       player.Position
+		birthYear int
+	}{
+		{"g1", "Sam", "Keeper", "", player.PositionGK, 1994},
+		{"d1", "Dan", "Back", "", player.PositionDEF, 1997},
+		{"m1", "Ricardo", "Izecson", "Kaka", player.PositionMID, 1999},
+		{"f1", "Fred", "Striker", "", player.PositionFWD, 2001},
+	}
+	for _, s := range squad {
+		p := player.NewPlayer(player.PlayerID(s.id), s.first, s.last, s.pos, time.Date(s.birthYear, 3, 14, 0, 0, 0, 0, time.UTC))
+		p.Nickname = s.nickname
+		p.CurrentTeamID = "golden"
+		p.MarketValue, p.Wage = 5000000, 20000
+		p.CreatedAt, p.UpdatedAt = at, at
+		if err := tm.AddPlayer(*p); err != nil {
+			t.Fatalf("AddPlayer(%s): %v", s.id, err)
+		}
+	}
+	if err := tm.SetCaptain("m1"); err != nil {
+		t.Fatal(err)
+	}
+	tm.AddRival("rivals")
+	tm.UpdateForm(MatchResult{MatchID: "m-1", Opponent: "rivals", IsHome: true, GoalsFor: 2, GoalsAgainst: 1, Result: "W"})
+
+	tm.CreatedAt, tm.UpdatedAt = at, at
+	return tm
+}
+
+// indentJSON formats JSON for readable golden files
+func indentJSON(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, data, "", "  "); err != nil {
+		t.Fatalf("indent: %v", err)
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
+
+func TestMarshalSaveGolden(t *testing.T) {
+	data, err := goldenTeam(t).MarshalSave()
+	if err != nil {
+		t.Fatalf("MarshalSave: %v", err)
+	}
+	got := indentJSON(t, data)
+
+	path := filepath.Join("testdata", "save_v1.golden")
+	if *updateGolden {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read golden file: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("save differs from %s; run go test -update if the change is intended\ngot:\n%s", path, got)
+	}
+}
+
+func TestUnmarshalSaveGolden(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "save_v1.golden"))
+	if err != nil {
+		t.Fatalf("read golden file: %v", err)
+	}
+
+	loaded, err := UnmarshalSave(data)
+	if err != nil {
+		t.Fatalf("UnmarshalSave: %v", err)
+	}
+
+	want := goldenTeam(t).Snapshot()
+	if got := loaded.Snapshot(); !reflect.DeepEqual(got, want) {
+		t.Errorf("loaded team differs from the saved one\ngot:  %+v\nwant: %+v", got, want)
+	}
+	if p, err := loaded.GetPlayer("m1"); err != nil || p.FullName() != "Kaka" {
+		t.Errorf("GetPlayer(m1) = %v, %v", p, err)
+	}
+
+	// Saving the loaded team reproduces the file
+	again, err := loaded.MarshalSave()
+	if err != nil {
+		t.Fatalf("MarshalSave: %v", err)
+	}
+	if !bytes.Equal(indentJSON(t, again), data) {
+		t.Error("re-saving a loaded team changed the save")
+	}
+}
+
+func TestUnmarshalSaveRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"not json", "{", "invalid save"},
+		{"no version", `{"team":{}}`, "no format version"},
+		{"newer version", `{"version":99,"team":{}}`, "newer than supported"},
+		{"bad team", `{"version":1,"team":[]}`, "invalid save team"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := UnmarshalSave([]byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
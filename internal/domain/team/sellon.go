@@ -0,0 +1,104 @@
+// domain/team/sellon.go
+package team
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+// SellOnClause entitles a former club to a share of the profit when the
+// player is sold on
+type SellOnClause struct {
+	PlayerID    string
+	Percent     float64 // Share of the profit owed, 0-100
+	Beneficiary string  // Club owed the share
+}
+
+// RegisterSellOnClause records that a share of the profit on a future sale
+// of the player is owed to a former club, replacing any earlier clause
+func (fm *FinancialManager) RegisterSellOnClause(playerID string, percent float64, beneficiary string) error {
+	if playerID == "" || beneficiary == "" || percent <= 0 || percent > 100 {
+		return common.ErrInvalidSellOnClause.WithDetails(map[string]interface{}{
+			"player_id":   playerID,
+			"percent":     percent,
+			"beneficiary": beneficiary,
+		})
+	}
+
+	fm.team.mu.Lock()
+	defer fm.team.mu.Unlock()
+
+	if fm.team.SellOnClauses == nil {
+		fm.team.SellOnClauses = make(map[string]SellOnClause)
+	}
+	fm.team.SellOnClauses[playerID] = SellOnClause{
+		PlayerID:    playerID,
+		Percent:     percent,
+		Beneficiary: beneficiary,
+	}
+	return nil
+}
+
+// ExecuteTransferOut records the fee for selling a player. If a sell-on
+// clause applies, the share of the profit over the recorded purchase fee is
+// paid out as a second transfer transaction and the clause is settled.
+func (fm *FinancialManager) ExecuteTransferOut(playerID string, fee int64) ([]Transaction, error) {
+	if fee < 0 {
+		return nil, fmt.Errorf("transfer fee cannot be negative")
+	}
+
+	fm.team.mu.Lock()
+	clause, hasClause := fm.team.SellOnClauses[playerID]
+	delete(fm.team.SellOnClauses, playerID)
+	purchaseFee := fm.purchaseFee(playerID)
+	fm.team.mu.Unlock()
+
+	txs := []Transaction{
+		fm.RecordTransaction(TransactionTransferOut, fee, fmt.Sprintf("Sale of %s", playerID), playerID),
+	}
+
+	if owed := sellOnOwed(clause, fee, purchaseFee); hasClause && owed > 0 {
+		desc := fmt.Sprintf("Sell-on payment to %s for %s", clause.Beneficiary, playerID)
+		txs = append(txs, fm.RecordTransaction(TransactionTransferOut, -owed, desc, playerID))
+	}
+
+	return txs, nil
+}
+
+// purchaseFee returns what the team last paid for a player according to
+// the ledger. The caller must hold the team lock.
+func (fm *FinancialManager) purchaseFee(playerID string) int64 {
+	for i := len(fm.team.Transactions) - 1; i >= 0; i-- {
+		tx := fm.team.Transactions[i]
+		if tx.Type == TransactionTransferIn && tx.PlayerID == playerID {
+			if tx.Amount < 0 {
+				return -tx.Amount
+			}
+			return tx.Amount
+		}
+	}
+	return 0
+}
+
+// sellOnOwed calculates the share of the profit owed under a clause
+func sellOnOwed(clause SellOnClause, fee, purchaseFee int64) int64 {
+	profit := fee - purchaseFee
+	if profit <= 0 {
+		return 0
+	}
+	return int64(math.Round(float64(profit) * clause.Percent / 100))
+}
+
+// copySellOnClauses copies the outstanding clauses
+func copySellOnClauses(clauses map[string]SellOnClause) map[string]SellOnClause {
+	if clauses == nil {
+		return nil
+	}
+	copied := make(map[string]SellOnClause, len(clauses))
+	for k, v := range clauses {
+		copied[k] = v
+	}
+	return copied
+}
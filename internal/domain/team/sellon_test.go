@@ -0,0 +1,125 @@
+// domain/team/sellon_test.go
+package team
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+func TestExecuteTransferOutSellOn(t *testing.T) {
+	tests := []struct {
+		name       string
+		bought     int64
+		percent    float64 // 0 for no clause
+		fee        int64
+		wantPayout int64
+	}{
+		{"profitable resale pays the share", 10000000, 20, 25000000, 3000000},
+		{"share is rounded", 1000, 33.3, 2000, 333},
+		{"sold at a loss", 10000000, 20, 8000000, 0},
+		{"sold at cost", 10000000, 20, 10000000, 0},
+		{"never bought counts the whole fee as profit", 0, 10, 5000000, 500000},
+		{"no clause", 10000000, 0, 25000000, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newTestTeam()
+			fm := NewFinancialManager(tm)
+			if tt.bought > 0 {
+				fm.RecordTransaction(TransactionTransferIn, -tt.bought, "signing", "p1")
+			}
+			if tt.percent > 0 {
+				if err := fm.RegisterSellOnClause("p1", tt.percent, "old-club"); err != nil {
+					t.Fatal(err)
+				}
+			}
+			budget := tm.Budget
+
+			txs, err := fm.ExecuteTransferOut("p1", tt.fee)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			wantTxs := 1
+			if tt.wantPayout > 0 {
+				wantTxs = 2
+			}
+			if len(txs) != wantTxs {
+				t.Fatalf("got %d transactions, want %d", len(txs), wantTxs)
+			}
+			for _, tx := range txs {
+				if tx.Type != TransactionTransferOut || tx.PlayerID != "p1" {
+					t.Errorf("transaction = %+v, want a transfer out for p1", tx)
+				}
+			}
+			if txs[0].Amount != tt.fee {
+				t.Errorf("sale amount = %d, want %d", txs[0].Amount, tt.fee)
+			}
+			if tt.wantPayout > 0 && txs[1].Amount != -tt.wantPayout {
+				t.Errorf("sell-on amount = %d, want %d", txs[1].Amount, -tt.wantPayout)
+			}
+			if got, want := tm.Budget-budget, tt.fee-tt.wantPayout; got != want {
+				t.Errorf("budget changed by %d, want %d", got, want)
+			}
+			if _, ok := tm.SellOnClauses["p1"]; ok {
+				t.Error("clause still outstanding after the sale")
+			}
+		})
+	}
+}
+
+func TestExecuteTransferOutSettlesClauseOnce(t *testing.T) {
+	tm := newTestTeam()
+	fm := NewFinancialManager(tm)
+	fm.RecordTransaction(TransactionTransferIn, -1000000, "signing", "p1")
+	if err := fm.RegisterSellOnClause("p1", 50, "old-club"); err != nil {
+		t.Fatal(err)
+	}
+
+	if txs, _ := fm.ExecuteTransferOut("p1", 3000000); len(txs) != 2 {
+		t.Fatalf("first sale recorded %d transactions, want 2", len(txs))
+	}
+	// Buying the player back and selling again owes nothing further
+	fm.RecordTransaction(TransactionTransferIn, -1000000, "re-signing", "p1")
+	if txs, _ := fm.ExecuteTransferOut("p1", 3000000); len(txs) != 1 {
+		t.Errorf("second sale recorded %d transactions, want 1", len(txs))
+	}
+}
+
+func TestRegisterSellOnClauseValidation(t *testing.T) {
+	tests := []struct {
+		name        string
+		playerID    string
+		percent     float64
+		beneficiary string
+		wantErr     bool
+	}{
+		{"valid", "p1", 15, "old-club", false},
+		{"whole profit", "p1", 100, "old-club", false},
+		{"zero percent", "p1", 0, "old-club", true},
+		{"over 100 percent", "p1", 101, "old-club", true},
+		{"missing player", "", 15, "old-club", true},
+		{"missing beneficiary", "p1", 15, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewFinancialManager(newTestTeam()).RegisterSellOnClause(tt.playerID, tt.percent, tt.beneficiary)
+			if tt.wantErr && !errors.Is(err, common.ErrInvalidSellOnClause) {
+				t.Errorf("RegisterSellOnClause() = %v, want ErrInvalidSellOnClause", err)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("RegisterSellOnClause() = %v, want nil", err)
+			}
+		})
+	}
+}
+
+func TestExecuteTransferOutRejectsNegativeFee(t *testing.T) {
+	if _, err := NewFinancialManager(newTestTeam()).ExecuteTransferOut("p1", -1); err == nil {
+		t.Error("ExecuteTransferOut(-1) = nil, want an error")
+	}
+}
@@ -0,0 +1,74 @@
+// domain/team/setpieces.go
+package team
+
+import (
+	"sort"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// SetPieceTaker is a nominated taker with their suitability score
+type SetPieceTaker struct {
+	PlayerID player.PlayerID
+	Score    float64
+}
+
+// SetPieceTakers holds the designated takers for each set piece.
+// A zero-value taker means no suitable player is available.
+type SetPieceTakers struct {
+	Penalty  SetPieceTaker
+	FreeKick SetPieceTaker
+	Corner   SetPieceTaker
+}
+
+// GetSetPieceTakers nominates the best available penalty, free-kick and
+// corner takers. Unavailable players are skipped so the next best steps in.
+func (sm *SquadManager) GetSetPieceTakers() SetPieceTakers {
+	candidates := []player.Player{}
+	for _, p := range sm.team.GetAvailablePlayers() {
+		if p.Position != player.PositionGK {
+			candidates = append(candidates, p)
+		}
+	}
+
+	return SetPieceTakers{
+		Penalty:  bestTaker(candidates, PenaltyScore),
+		FreeKick: bestTaker(candidates, FreeKickScore),
+		Corner:   bestTaker(candidates, CornerScore),
+	}
+}
+
+// bestTaker picks the highest scoring candidate, breaking ties by ID
+func bestTaker(candidates []player.Player, score func(a player.Attributes) float64) SetPieceTaker {
+	takers := make([]SetPieceTaker, 0, len(candidates))
+	for _, p := range candidates {
+		takers = append(takers, SetPieceTaker{PlayerID: p.ID, Score: score(p.Attributes)})
+	}
+
+	sort.Slice(takers, func(i, j int) bool {
+		if takers[i].Score != takers[j].Score {
+			return takers[i].Score > takers[j].Score
+		}
+		return takers[i].PlayerID < takers[j].PlayerID
+	})
+
+	if len(takers) == 0 {
+		return SetPieceTaker{}
+	}
+	return takers[0]
+}
+
+// PenaltyScore rates a player from the spot, favouring composed finishers
+func PenaltyScore(a player.Attributes) float64 {
+	return float64(a.Shooting)*0.7 + float64(a.BallControl)*0.3
+}
+
+// FreeKickScore rates a free-kick taker on technique as well as power
+func FreeKickScore(a player.Attributes) float64 {
+	return float64(a.Shooting)*0.5 + float64(a.BallControl)*0.3 + float64(a.Passing)*0.2
+}
+
+// CornerScore rates a corner taker on delivery
+func CornerScore(a player.Attributes) float64 {
+	return float64(a.Passing)*0.6 + float64(a.BallControl)*0.3 + float64(a.Perception)*0.1
+}
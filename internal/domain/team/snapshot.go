@@ -0,0 +1,136 @@
+// domain/team/snapshot.go
+package team
+
+import (
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// TeamSnapshot is an independent copy of a team's state for checkpoints
+// and save games
+type TeamSnapshot struct {
+	ID        TeamID
+	Name      string
+	ShortName string
+	Founded   int
+	Stadium   Stadium
+
+	Players       []player.Player
+	Captain       *player.PlayerID
+	ViceCaptain   *player.PlayerID
+	SharedMatches map[string]int
+	Rivals        []TeamID
+
+	Formation Formation
+	Tactics   TeamTactics
+	Rules     SquadRules
+
+	ManagerName string
+
+	Budget        int64
+	WageBudget    int64
+	Transactions  []Transaction
+	SellOnClauses map[string]SellOnClause
+
+	CurrentForm []MatchResult
+	SeasonStats TeamSeasonStats
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Snapshot deep-copies the team's state
+func (t *Team) Snapshot() TeamSnapshot {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return TeamSnapshot{
+		ID:            t.ID,
+		Name:          t.Name,
+		ShortName:     t.ShortName,
+		Founded:       t.Founded,
+		Stadium:       t.Stadium,
+		Players:       copyPlayers(t.Players),
+		Captain:       copyPlayerID(t.Captain),
+		ViceCaptain:   copyPlayerID(t.ViceCaptain),
+		SharedMatches: copySharedMatches(t.SharedMatches),
+		Rivals:        append([]TeamID(nil), t.Rivals...),
+		Formation:     t.Formation,
+		Tactics:       t.Tactics,
+		Rules:         copySquadRules(t.Rules),
+		ManagerName:   t.ManagerName,
+		Budget:        t.Budget,
+		WageBudget:    t.WageBudget,
+		Transactions:  append([]Transaction(nil), t.Transactions...),
+		SellOnClauses: copySellOnClauses(t.SellOnClauses),
+		CurrentForm:   append([]MatchResult(nil), t.CurrentForm...),
+		SeasonStats:   t.SeasonStats,
+		CreatedAt:     t.CreatedAt,
+		UpdatedAt:     t.UpdatedAt,
+	}
+}
+
+// RestoreTeam rebuilds a team from a snapshot. The restored team shares no
+// state with the snapshot, so either can be changed independently.
+func RestoreTeam(s TeamSnapshot) *Team {
+	t := &Team{
+		ID:            s.ID,
+		Name:          s.Name,
+		ShortName:     s.ShortName,
+		Founded:       s.Founded,
+		Stadium:       s.Stadium,
+		Players:       copyPlayers(s.Players),
+		Captain:       copyPlayerID(s.Captain),
+		ViceCaptain:   copyPlayerID(s.ViceCaptain),
+		SharedMatches: copySharedMatches(s.SharedMatches),
+		Rivals:        append([]TeamID(nil), s.Rivals...),
+		Formation:     s.Formation,
+		Tactics:       s.Tactics,
+		Rules:         copySquadRules(s.Rules),
+		ManagerName:   s.ManagerName,
+		Budget:        s.Budget,
+		WageBudget:    s.WageBudget,
+		Transactions:  append([]Transaction(nil), s.Transactions...),
+		SellOnClauses: copySellOnClauses(s.SellOnClauses),
+		CurrentForm:   append([]MatchResult(nil), s.CurrentForm...),
+		SeasonStats:   s.SeasonStats,
+		CreatedAt:     s.CreatedAt,
+		UpdatedAt:     s.UpdatedAt,
+	}
+	for i := range t.Players {
+		t.Players[i].RecomputeRating() // Saves don't carry the cache
+	}
+	t.reindex()
+	return t
+}
+
+// copyPlayers deep-copies a squad, including each player's nested state
+func copyPlayers(players []player.Player) []player.Player {
+	copied := make([]player.Player, len(players))
+	for i := range players {
+		copied[i] = players[i].Clone()
+	}
+	return copied
+}
+
+// copyPlayerID copies an optional player reference
+func copyPlayerID(id *player.PlayerID) *player.PlayerID {
+	if id == nil {
+		return nil
+	}
+	copied := *id
+	return &copied
+}
+
+// copySharedMatches copies the partnership counts
+func copySharedMatches(shared map[string]int) map[string]int {
+	if shared == nil {
+		return nil
+	}
+	copied := make(map[string]int, len(shared))
+	for k, v := range shared {
+		copied[k] = v
+	}
+	return copied
+}
@@ -0,0 +1,90 @@
+// domain/team/snapshot_test.go
+package team
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// newSnapshotTeam creates a team with state in every copied collection
+func newSnapshotTeam(t *testing.T) *Team {
+	t.Helper()
+	tm := newTestTeam()
+	for _, id := range []string{"a", "b"} {
+		p := newTestPlayer(id, player.PositionMID, 27)
+		p.CareerStats.SeasonStats = []player.SeasonStats{{SeasonID: "2025", Goals: 4}}
+		if err := tm.AddPlayer(p); err != nil {
+			t.Fatalf("AddPlayer(%s): %v", id, err)
+		}
+	}
+	if err := tm.SetCaptain("a"); err != nil {
+		t.Fatal(err)
+	}
+	tm.Rules.MaxPerPosition = map[player.Position]int{player.PositionGK: 3}
+	tm.SharedMatches = map[string]int{"a|b": 12}
+	tm.AddRival("rivals")
+	NewFinancialManager(tm).RecordTransaction(TransactionTransferIn, -1000, "signing", "b")
+	tm.UpdateForm(MatchResult{MatchID: "m1", Result: "W"})
+	return tm
+}
+
+func TestRestoredTeamIsIndependent(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(tm *Team)
+	}{
+		{"player attributes", func(tm *Team) {
+			_ = tm.UpdatePlayer("a", func(p *player.Player) { p.Attributes.Passing = 1 })
+		}},
+		{"player season stats", func(tm *Team) {
+			_ = tm.UpdatePlayer("a", func(p *player.Player) { p.CareerStats.SeasonStats[0].Goals = 40 })
+		}},
+		{"squad", func(tm *Team) { _ = tm.RemovePlayer("b") }},
+		{"captain", func(tm *Team) { *tm.Captain = "b" }},
+		{"squad rules", func(tm *Team) { tm.Rules.MaxPerPosition[player.PositionGK] = 1 }},
+		{"shared matches", func(tm *Team) { tm.SharedMatches["a|b"]++ }},
+		{"rivals", func(tm *Team) { tm.Rivals[0] = "others" }},
+		{"transactions", func(tm *Team) { tm.Transactions[0].Amount = 0 }},
+		{"form", func(tm *Team) { tm.CurrentForm[0].Result = "L" }},
+		{"tactics", func(tm *Team) { tm.Tactics.Mentality = "defensive" }},
+		{"finances", func(tm *Team) { tm.Budget = -1 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newSnapshotTeam(t)
+			snapshot, want := tm.Snapshot(), tm.Snapshot()
+
+			restored := RestoreTeam(snapshot)
+			tt.mutate(restored)
+
+			if !reflect.DeepEqual(snapshot, want) {
+				t.Errorf("changing the restored team's %s changed the snapshot", tt.name)
+			}
+			if again := RestoreTeam(snapshot); reflect.DeepEqual(again.Snapshot(), restored.Snapshot()) {
+				t.Errorf("rolling back did not undo the change to %s", tt.name)
+			}
+		})
+	}
+}
+
+func TestSnapshotIsIndependentOfTeam(t *testing.T) {
+	tm := newSnapshotTeam(t)
+	snapshot := tm.Snapshot()
+
+	_ = tm.UpdatePlayer("b", func(p *player.Player) { p.CareerStats.SeasonStats[0].Goals = 0 })
+	tm.SharedMatches["a|b"] = 0
+	tm.Rules.MaxPerPosition[player.PositionGK] = 0
+
+	if got := snapshot.Players[1].CareerStats.SeasonStats[0].Goals; got != 4 {
+		t.Errorf("snapshot season goals = %d after changing the team, want 4", got)
+	}
+	if got := snapshot.SharedMatches["a|b"]; got != 12 {
+		t.Errorf("snapshot shared matches = %d after changing the team, want 12", got)
+	}
+	if got := snapshot.Rules.MaxPerPosition[player.PositionGK]; got != 3 {
+		t.Errorf("snapshot goalkeeper limit = %d after changing the team, want 3", got)
+	}
+}
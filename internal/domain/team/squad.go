@@ -23,14 +23,14 @@ func NewSquadManager(team *Team) *SquadManager {
 func (sm *SquadManager) GetSquadDepth() map[player.Position][]player.Player {
 	depth := make(map[player.Position][]player.Player)
 
-	for _, p := range sm.team.Players {
+	for _, p := range sm.team.players() {
 		depth[p.Position] = append(depth[p.Position], p)
 	}
 
 	// Sort by rating
 	for pos := range depth {
 		sort.Slice(depth[pos], func(i, j int) bool {
-			return depth[pos][i].GetOverallRating() > depth[pos][j].GetOverallRating()
+			return depth[pos][i].GetOverallRatingFloat() > depth[pos][j].GetOverallRatingFloat()
 		})
 	}
 
@@ -39,22 +39,23 @@ func (sm *SquadManager) GetSquadDepth() map[player.Position][]player.Player {
 
 // GetSquadAge calculates average squad age
 func (sm *SquadManager) GetSquadAge() float64 {
-	if len(sm.team.Players) == 0 {
+	players := sm.team.players()
+	if len(players) == 0 {
 		return 0
 	}
 
 	totalAge := 0
-	for _, p := range sm.team.Players {
+	for _, p := range players {
 		totalAge += p.Age()
 	}
 
-	return float64(totalAge) / float64(len(sm.team.Players))
+	return float64(totalAge) / float64(len(players))
 }
 
 // GetSquadValue calculates total squad value
 func (sm *SquadManager) GetSquadValue() int64 {
 	var total int64
-	for _, p := range sm.team.Players {
+	for _, p := range sm.team.players() {
 		total += p.MarketValue
 	}
 	return total
@@ -63,7 +64,7 @@ func (sm *SquadManager) GetSquadValue() int64 {
 // GetWageBill calculates total weekly wages
 func (sm *SquadManager) GetWageBill() int64 {
 	var total int64
-	for _, p := range sm.team.Players {
+	for _, p := range sm.team.players() {
 		total += p.Wage
 	}
 	return total
@@ -72,7 +73,7 @@ func (sm *SquadManager) GetWageBill() int64 {
 // GetYouthProspects returns players under 21
 func (sm *SquadManager) GetYouthProspects() []player.Player {
 	prospects := []player.Player{}
-	for _, p := range sm.team.Players {
+	for _, p := range sm.team.players() {
 		if p.Age() < 21 {
 			prospects = append(prospects, p)
 		}
@@ -83,7 +84,7 @@ func (sm *SquadManager) GetYouthProspects() []player.Player {
 // GetVeterans returns players over 30
 func (sm *SquadManager) GetVeterans() []player.Player {
 	veterans := []player.Player{}
-	for _, p := range sm.team.Players {
+	for _, p := range sm.team.players() {
 		if p.Age() > 30 {
 			veterans = append(veterans, p)
 		}
@@ -94,7 +95,7 @@ func (sm *SquadManager) GetVeterans() []player.Player {
 // GetInjuredPlayers returns all injured players
 func (sm *SquadManager) GetInjuredPlayers() []player.Player {
 	injured := []player.Player{}
-	for _, p := range sm.team.Players {
+	for _, p := range sm.team.players() {
 		if p.Status == player.StatusInjured {
 			injured = append(injured, p)
 		}
@@ -105,7 +106,7 @@ func (sm *SquadManager) GetInjuredPlayers() []player.Player {
 // GetSuspendedPlayers returns all suspended players
 func (sm *SquadManager) GetSuspendedPlayers() []player.Player {
 	suspended := []player.Player{}
-	for _, p := range sm.team.Players {
+	for _, p := range sm.team.players() {
 		if p.Status == player.StatusSuspended {
 			suspended = append(suspended, p)
 		}
@@ -113,9 +114,42 @@ func (sm *SquadManager) GetSuspendedPlayers() []player.Player {
 	return suspended
 }
 
+// GetRetiringPlayers returns players who have retired but are still
+// registered with the squad
+func (sm *SquadManager) GetRetiringPlayers() []player.Player {
+	retiring := []player.Player{}
+	for _, p := range sm.team.players() {
+		if p.Status == player.StatusRetired {
+			retiring = append(retiring, p)
+		}
+	}
+	return retiring
+}
+
+// RemoveRetiredPlayers takes retired players off the squad list
+func (sm *SquadManager) RemoveRetiredPlayers() []player.PlayerID {
+	removed := []player.PlayerID{}
+	for _, p := range sm.GetRetiringPlayers() {
+		if err := sm.team.RemovePlayer(p.ID); err == nil {
+			removed = append(removed, p.ID)
+		}
+	}
+	return removed
+}
+
 // RecommendLineup suggests best lineup for formation
 func (sm *SquadManager) RecommendLineup(formation Formation) (*Lineup, error) {
-	available := sm.team.GetAvailablePlayers()
+	return sm.recommendLineupFrom(sm.team.GetAvailablePlayers(), formation)
+}
+
+// RecommendLineupWithFitness suggests the best lineup from players at or
+// above a chosen fitness, rather than the conservative default
+func (sm *SquadManager) RecommendLineupWithFitness(formation Formation, minFitness float64) (*Lineup, error) {
+	return sm.recommendLineupFrom(sm.team.GetSelectablePlayers(minFitness), formation)
+}
+
+// recommendLineupFrom picks the best lineup from a pool of available players
+func (sm *SquadManager) recommendLineupFrom(available []player.Player, formation Formation) (*Lineup, error) {
 	requirements := formation.GetPositionRequirements()
 
 	lineup := &Lineup{
@@ -177,7 +211,7 @@ func (sm *SquadManager) getBestCandidates(available []player.Player, pos player.
 
 	// Sort by position-specific rating
 	sort.Slice(candidates, func(i, j int) bool {
-		return candidates[i].GetOverallRating() > candidates[j].GetOverallRating()
+		return candidates[i].GetRatingAtPosition(pos) > candidates[j].GetRatingAtPosition(pos)
 	})
 
 	return candidates
@@ -185,11 +219,11 @@ func (sm *SquadManager) getBestCandidates(available []player.Player, pos player.
 
 // selectCaptain chooses captain from starters
 func (sm *SquadManager) selectCaptain(starters []player.PlayerID) *player.PlayerID {
-	if sm.team.Captain != nil {
+	if current := sm.team.CaptainID(); current != nil {
 		// Check if current captain is starting
 		for _, id := range starters {
-			if id == *sm.team.Captain {
-				return sm.team.Captain
+			if id == *current {
+				return current
 			}
 		}
 	}
@@ -200,9 +234,7 @@ func (sm *SquadManager) selectCaptain(starters []player.PlayerID) *player.Player
 
 	for _, id := range starters {
 		if p, err := sm.team.GetPlayer(id); err == nil {
-			// Score based on age, experience, and leadership
-			score := float64(p.Age()) + float64(p.CareerStats.TotalMatches)/10
-			if score > bestScore {
+			if score := captaincyScore(p); score > bestScore {
 				bestScore = score
 				bestPlayer = p
 			}
@@ -215,3 +247,8 @@ func (sm *SquadManager) selectCaptain(starters []player.PlayerID) *player.Player
 
 	return nil
 }
+
+// captaincyScore rates a captaincy candidate by age and experience
+func captaincyScore(p *player.Player) float64 {
+	return float64(p.Age()) + float64(p.CareerStats.TotalMatches)/10
+}
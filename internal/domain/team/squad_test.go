@@ -0,0 +1,88 @@
+// domain/team/squad_test.go
+package team
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+func TestRemoveRetiredPlayers(t *testing.T) {
+	tm := newTestTeam()
+	for _, id := range []string{"a", "b", "c"} {
+		if err := tm.AddPlayer(newTestPlayer(id, player.PositionDEF, 34)); err != nil {
+			t.Fatalf("AddPlayer(%s): %v", id, err)
+		}
+	}
+	for _, id := range []player.PlayerID{"a", "c"} {
+		if err := tm.UpdatePlayer(id, func(p *player.Player) { _ = p.SetStatus(player.StatusRetired) }); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sm := NewSquadManager(tm)
+
+	retiring := []player.PlayerID{}
+	for _, p := range sm.GetRetiringPlayers() {
+		retiring = append(retiring, p.ID)
+	}
+	if want := []player.PlayerID{"a", "c"}; !reflect.DeepEqual(retiring, want) {
+		t.Errorf("GetRetiringPlayers() = %v, want %v", retiring, want)
+	}
+	for _, p := range tm.GetAvailablePlayers() {
+		if p.Status == player.StatusRetired {
+			t.Errorf("retired player %s is available for selection", p.ID)
+		}
+	}
+
+	if removed := sm.RemoveRetiredPlayers(); !reflect.DeepEqual(removed, []player.PlayerID{"a", "c"}) {
+		t.Errorf("RemoveRetiredPlayers() = %v, want [a c]", removed)
+	}
+	if got := tm.PlayerCount(); got != 1 {
+		t.Errorf("PlayerCount() = %d after removal, want 1", got)
+	}
+	if removed := sm.RemoveRetiredPlayers(); len(removed) != 0 {
+		t.Errorf("second RemoveRetiredPlayers() = %v, want none", removed)
+	}
+}
+
+func TestRecommendLineupWithFitness(t *testing.T) {
+	tests := []struct {
+		name       string
+		minFitness float64
+		wantErr    bool
+	}{
+		{"default threshold leaves the tired keeper out", player.MinAvailableFitness, true},
+		{"threshold just above the keeper", 60.5, true},
+		{"threshold exactly at the keeper", 60, false},
+		{"relaxed threshold", 40, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newTestTeam()
+			addTestSquad(t, tm, map[player.Position]int{
+				player.PositionGK: 1, player.PositionDEF: 4, player.PositionMID: 4, player.PositionFWD: 2,
+			})
+			if err := tm.UpdatePlayer("GK0", func(p *player.Player) { p.Fitness = 60 }); err != nil {
+				t.Fatal(err)
+			}
+
+			lineup, err := NewSquadManager(tm).RecommendLineupWithFitness(Formation442, tt.minFitness)
+			if tt.wantErr {
+				if !errors.Is(err, common.ErrInsufficientPlayers) {
+					t.Errorf("RecommendLineupWithFitness(%v) = %v, want ErrInsufficientPlayers", tt.minFitness, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RecommendLineupWithFitness(%v): %v", tt.minFitness, err)
+			}
+			if lineup.Starters[0] != "GK0" {
+				t.Errorf("goalkeeper = %s, want the tired GK0", lineup.Starters[0])
+			}
+		})
+	}
+}
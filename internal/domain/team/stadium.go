@@ -0,0 +1,32 @@
+// domain/team/stadium.go
+package team
+
+import (
+	"fmt"
+)
+
+// ExpandStadium adds seats to the stadium, paying for them from the budget
+func (t *Team) ExpandStadium(additionalSeats int, cost int64) error {
+	if additionalSeats <= 0 {
+		return fmt.Errorf("additional seats must be positive, got %d", additionalSeats)
+	}
+	if cost < 0 {
+		return fmt.Errorf("expansion cost cannot be negative, got %d", cost)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if cost > t.Budget {
+		return fmt.Errorf("expansion costs %d but only %d is available", cost, t.Budget)
+	}
+
+	t.Stadium.Capacity += additionalSeats
+	NewFinancialManager(t).recordTransaction(
+		TransactionStadium,
+		-cost,
+		fmt.Sprintf("Stadium expansion of %d seats", additionalSeats),
+		"",
+	)
+	return nil
+}
@@ -0,0 +1,74 @@
+// domain/team/stadium_test.go
+package team
+
+import "testing"
+
+func TestExpandStadium(t *testing.T) {
+	tests := []struct {
+		name    string
+		seats   int
+		cost    int64
+		wantErr bool
+	}{
+		{"affordable", 5000, 4000000, false},
+		{"whole budget", 5000, 5000000, false},
+		{"free", 100, 0, false},
+		{"over budget", 5000, 5000001, true},
+		{"no seats", 0, 1000, true},
+		{"negative seats", -500, 1000, true},
+		{"negative cost", 5000, -1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newTestTeam()
+			tm.Budget = 5000000
+
+			err := tm.ExpandStadium(tt.seats, tt.cost)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExpandStadium() = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			wantCapacity, wantBudget, wantTransactions := 30000, int64(5000000), 0
+			if !tt.wantErr {
+				wantCapacity += tt.seats
+				wantBudget -= tt.cost
+				wantTransactions = 1
+			}
+			if tm.Stadium.Capacity != wantCapacity {
+				t.Errorf("Capacity = %d, want %d", tm.Stadium.Capacity, wantCapacity)
+			}
+			if tm.Budget != wantBudget {
+				t.Errorf("Budget = %d, want %d", tm.Budget, wantBudget)
+			}
+			if len(tm.Transactions) != wantTransactions {
+				t.Fatalf("recorded %d transactions, want %d", len(tm.Transactions), wantTransactions)
+			}
+			if wantTransactions == 1 {
+				if tx := tm.Transactions[0]; tx.Type != TransactionStadium || tx.Amount != -tt.cost {
+					t.Errorf("transaction = %+v, want a stadium debit of %d", tx, tt.cost)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandStadiumRaisesRevenue(t *testing.T) {
+	tm := newTestTeam()
+	tm.Budget = 10000000
+	fm := NewFinancialManager(tm)
+
+	const demand = 40000 // More fans than the 30,000 seats
+	before := fm.ProcessMatchRevenue(demand, true)
+	if err := tm.ExpandStadium(10000, 8000000); err != nil {
+		t.Fatalf("ExpandStadium: %v", err)
+	}
+	after := fm.ProcessMatchRevenue(demand, true)
+
+	if after <= before {
+		t.Errorf("revenue = %d after expansion, want more than %d", after, before)
+	}
+	if away := fm.ProcessMatchRevenue(demand, false); away != 0 {
+		t.Errorf("away revenue = %d, want 0", away)
+	}
+}
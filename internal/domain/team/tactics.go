@@ -0,0 +1,81 @@
+// domain/team/tactics.go
+package team
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+)
+
+// Mentality represents the team's overall approach
+type Mentality string
+
+const (
+	MentalityDefensive Mentality = "defensive"
+	MentalityBalanced  Mentality = "balanced"
+	MentalityAttacking Mentality = "attacking"
+)
+
+// TeamTactics represents the team's tactical instructions (1-10 scales)
+type TeamTactics struct {
+	Mentality     Mentality
+	Tempo         int // Speed of build-up play
+	Pressing      int // Intensity of pressing without the ball
+	DefensiveLine int // Height of the defensive line
+	Width         int // How wide the team plays
+}
+
+// DefaultTactics returns balanced tactical instructions
+func DefaultTactics() TeamTactics {
+	return TeamTactics{
+		Mentality:     MentalityBalanced,
+		Tempo:         5,
+		Pressing:      5,
+		DefensiveLine: 5,
+		Width:         5,
+	}
+}
+
+// Validate checks that tactical settings are within range
+func (t TeamTactics) Validate() error {
+	switch t.Mentality {
+	case MentalityDefensive, MentalityBalanced, MentalityAttacking:
+	default:
+		return common.ErrInvalidTactics
+	}
+
+	for _, v := range []int{t.Tempo, t.Pressing, t.DefensiveLine, t.Width} {
+		if v < 1 || v > 10 {
+			return common.ErrInvalidTactics
+		}
+	}
+
+	return nil
+}
+
+// Tactical load per step away from the midpoint of the 1-10 scales
+const (
+	pressingFatigue = 0.04
+	tempoFatigue    = 0.02
+	pressingCards   = 0.05
+	tempoCards      = 0.02
+	tacticsMidpoint = 5
+)
+
+// FatigueMultiplier scales how quickly players tire under these tactics.
+// High pressing and a fast tempo wear a team down; a low block conserves
+// energy. Invalid tactics are treated as neutral.
+func (t TeamTactics) FatigueMultiplier() float64 {
+	if t.Validate() != nil {
+		return 1.0
+	}
+	return 1 + pressingFatigue*float64(t.Pressing-tacticsMidpoint) + tempoFatigue*float64(t.Tempo-tacticsMidpoint)
+}
+
+// CardMultiplier scales a team's booking risk under these tactics, which
+// rises with the number of challenges pressing and tempo demand. Invalid
+// tactics are treated as neutral.
+func (t TeamTactics) CardMultiplier() float64 {
+	if t.Validate() != nil {
+		return 1.0
+	}
+	return 1 + pressingCards*float64(t.Pressing-tacticsMidpoint) + tempoCards*float64(t.Tempo-tacticsMidpoint)
+}
@@ -0,0 +1,34 @@
+// domain/team/tactics_test.go
+package team
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTacticsMultipliers(t *testing.T) {
+	tests := []struct {
+		name        string
+		tactics     TeamTactics
+		wantFatigue float64
+		wantCards   float64
+	}{
+		{"default", DefaultTactics(), 1, 1},
+		{"high press", PresetTactics(PresetHighPress), 1.24, 1.28},
+		{"low block", PresetTactics(PresetDefensive), 0.84, 0.81},
+		{"possession", PresetTactics(PresetPossession), 1.04, 1.06},
+		{"invalid treated as neutral", TeamTactics{Mentality: MentalityBalanced, Tempo: 11, Pressing: 10, DefensiveLine: 5, Width: 5}, 1, 1},
+		{"unknown mentality treated as neutral", TeamTactics{Mentality: "reckless", Tempo: 10, Pressing: 10, DefensiveLine: 5, Width: 5}, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tactics.FatigueMultiplier(); math.Abs(got-tt.wantFatigue) > 1e-9 {
+				t.Errorf("FatigueMultiplier() = %.3f, want %.3f", got, tt.wantFatigue)
+			}
+			if got := tt.tactics.CardMultiplier(); math.Abs(got-tt.wantCards) > 1e-9 {
+				t.Errorf("CardMultiplier() = %.3f, want %.3f", got, tt.wantCards)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package team
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/devvspaces/fantasy_league/internal/domain/common"
@@ -12,29 +14,54 @@ import (
 // TeamID represents a unique team identifier
 type TeamID string
 
-// Team represents a football team
+// Team represents a football team. Its methods, and those of the managers
+// built on it, are safe for concurrent use; code that reads or writes the
+// guarded fields directly must do its own synchronization.
 type Team struct {
+	// mu guards Players, Captain, ViceCaptain, Stadium, Budget, WageBudget,
+	// CurrentForm, SharedMatches, Rivals, Transactions, SellOnClauses,
+	// UpdatedAt and the player index
+	mu sync.RWMutex
+
+	// index maps player IDs to their position in Players
+	index map[player.PlayerID]int
+
 	ID        TeamID
 	Name      string
 	ShortName string
 	Founded   int
 	Stadium   Stadium
 
-	// Squad
+	// Squad. Players is the record of the squad; read it through Squad and
+	// change it through AddPlayer and RemovePlayer so the squad rules,
+	// captaincy and index stay consistent.
 	Players     []player.Player
 	Captain     *player.PlayerID
 	ViceCaptain *player.PlayerID
 
+	// SharedMatches counts appearances together, keyed by player pair
+	SharedMatches map[string]int
+
+	// Rivals are the teams this team contests derbies with
+	Rivals []TeamID
+
 	// Tactical setup
 	Formation Formation
 	Tactics   TeamTactics
 
+	// Registration limits
+	Rules SquadRules
+
 	// Staff
 	ManagerName string
 
 	// Financials
-	Budget     int64
-	WageBudget int64
+	Budget       int64
+	WageBudget   int64
+	Transactions []Transaction // Ledger of budget movements
+
+	// SellOnClauses are owed to former clubs, keyed by player ID
+	SellOnClauses map[string]SellOnClause
 
 	// Performance
 	CurrentForm []MatchResult // Last 5 matches
@@ -85,7 +112,9 @@ func NewTeam(id TeamID, name string, stadium Stadium) *Team {
 		Stadium:   stadium,
 		Formation: FormationDefault,
 		Tactics:   DefaultTactics(),
+		Rules:     DefaultSquadRules(),
 		Players:   []player.Player{},
+		index:     make(map[player.PlayerID]int),
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
@@ -93,60 +122,217 @@ func NewTeam(id TeamID, name string, stadium Stadium) *Team {
 
 // AddPlayer adds a player to the squad
 func (t *Team) AddPlayer(p player.Player) error {
-	// Check squad size limit
-	if len(t.Players) >= 30 {
-		return fmt.Errorf("squad size limit reached")
-	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
 
 	// Check if player already exists
-	for _, existing := range t.Players {
-		if existing.ID == p.ID {
-			return fmt.Errorf("player already in squad")
-		}
+	if _, ok := t.indexOf(p.ID); ok {
+		return fmt.Errorf("player already in squad")
+	}
+
+	// Check squad size and position limits
+	if err := t.checkSquadRules(p); err != nil {
+		return err
 	}
 
 	t.Players = append(t.Players, p)
+	if t.index == nil {
+		t.reindex()
+	} else {
+		t.index[p.ID] = len(t.Players) - 1
+	}
 	t.UpdatedAt = time.Now()
 	return nil
 }
 
 // RemovePlayer removes a player from the squad
 func (t *Team) RemovePlayer(playerID player.PlayerID) error {
-	for i, p := range t.Players {
-		if p.ID == playerID {
-			// Remove player
-			t.Players = append(t.Players[:i], t.Players[i+1:]...)
-
-			// Clear captain if needed
-			if t.Captain != nil && *t.Captain == playerID {
-				t.Captain = nil
-			}
-			if t.ViceCaptain != nil && *t.ViceCaptain == playerID {
-				t.ViceCaptain = nil
-			}
+	t.mu.Lock()
+	defer t.mu.Unlock()
 
-			t.UpdatedAt = time.Now()
-			return nil
-		}
+	i, ok := t.indexOf(playerID)
+	if !ok {
+		return fmt.Errorf("player not found in squad")
+	}
+
+	// Remove player
+	t.Players = append(t.Players[:i], t.Players[i+1:]...)
+	t.reindex()
+
+	// Clear captain if needed
+	if t.Captain != nil && *t.Captain == playerID {
+		t.Captain = nil
 	}
-	return fmt.Errorf("player not found in squad")
+	if t.ViceCaptain != nil && *t.ViceCaptain == playerID {
+		t.ViceCaptain = nil
+	}
+
+	t.UpdatedAt = time.Now()
+	return nil
 }
 
 // GetPlayer retrieves a player by ID
 func (t *Team) GetPlayer(playerID player.PlayerID) (*player.Player, error) {
-	for _, p := range t.Players {
-		if p.ID == playerID {
-			return &p, nil
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if i, ok := t.indexOf(playerID); ok {
+		p := t.Players[i]
+		return &p, nil
+	}
+	return nil, common.PlayerNotFound(string(playerID))
+}
+
+// indexOf finds a player's position in the squad. Lookups go through the
+// index, falling back to a scan if Players was changed without it. The
+// caller must hold the team lock.
+func (t *Team) indexOf(playerID player.PlayerID) (int, bool) {
+	if i, ok := t.index[playerID]; ok && i < len(t.Players) && t.Players[i].ID == playerID {
+		return i, true
+	}
+	for i := range t.Players {
+		if t.Players[i].ID == playerID {
+			return i, true
 		}
 	}
-	return nil, common.ErrPlayerNotFound
+	return 0, false
+}
+
+// reindex rebuilds the player index from the squad. The caller must hold
+// the team write lock.
+func (t *Team) reindex() {
+	t.index = make(map[player.PlayerID]int, len(t.Players))
+	for i := range t.Players {
+		t.index[t.Players[i].ID] = i
+	}
+}
+
+// Squad returns a copy of the squad. Changes to the returned players do
+// not affect the team.
+func (t *Team) Squad() []player.Player {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return copyPlayers(t.Players)
+}
+
+// players returns a shallow copy of the squad for read-only iteration
+func (t *Team) players() []player.Player {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return append([]player.Player(nil), t.Players...)
+}
+
+// PlayerCount returns the size of the squad
+func (t *Team) PlayerCount() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return len(t.Players)
+}
+
+// UpdatePlayer changes a squad player in place while holding the team
+// lock, refreshing the player's cached rating afterwards. fn must not call
+// back into the team; the player's ID cannot be changed.
+func (t *Team) UpdatePlayer(playerID player.PlayerID, fn func(p *player.Player)) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	i, ok := t.indexOf(playerID)
+	if !ok {
+		return common.PlayerNotFound(string(playerID))
+	}
+
+	fn(&t.Players[i])
+	t.Players[i].ID = playerID
+	t.Players[i].RecomputeRating()
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// UpdatePlayers changes every squad player in place while holding the team
+// lock, refreshing their cached ratings afterwards. fn must not call back
+// into the team; players' IDs cannot be changed.
+func (t *Team) UpdatePlayers(fn func(p *player.Player)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for i := range t.Players {
+		id := t.Players[i].ID
+		fn(&t.Players[i])
+		t.Players[i].ID = id
+		t.Players[i].RecomputeRating()
+	}
+	t.UpdatedAt = time.Now()
+}
+
+// CaptainID returns the captain, or nil when the captaincy is vacant
+func (t *Team) CaptainID() *player.PlayerID {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return copyPlayerID(t.Captain)
+}
+
+// ViceCaptainID returns the vice-captain, or nil when vacant
+func (t *Team) ViceCaptainID() *player.PlayerID {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return copyPlayerID(t.ViceCaptain)
+}
+
+// SetCaptain appoints a squad player as captain. A vice-captain promoted
+// this way leaves the vice-captaincy vacant.
+func (t *Team) SetCaptain(playerID player.PlayerID) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.indexOf(playerID); !ok {
+		return common.PlayerNotFound(string(playerID))
+	}
+
+	t.Captain = &playerID
+	if t.ViceCaptain != nil && *t.ViceCaptain == playerID {
+		t.ViceCaptain = nil
+	}
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// SetViceCaptain appoints a squad player other than the captain as
+// vice-captain
+func (t *Team) SetViceCaptain(playerID player.PlayerID) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.indexOf(playerID); !ok {
+		return common.PlayerNotFound(string(playerID))
+	}
+	if t.Captain != nil && *t.Captain == playerID {
+		return fmt.Errorf("player %s is already captain", playerID)
+	}
+
+	t.ViceCaptain = &playerID
+	t.UpdatedAt = time.Now()
+	return nil
 }
 
 // GetAvailablePlayers returns players available for selection
 func (t *Team) GetAvailablePlayers() []player.Player {
+	return t.GetSelectablePlayers(player.MinAvailableFitness)
+}
+
+// GetSelectablePlayers returns players fit enough for selection at a
+// chosen fitness threshold
+func (t *Team) GetSelectablePlayers(minFitness float64) []player.Player {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	available := []player.Player{}
 	for _, p := range t.Players {
-		if p.IsAvailable() {
+		if p.IsSelectable(minFitness) {
 			available = append(available, p)
 		}
 	}
@@ -155,6 +341,9 @@ func (t *Team) GetAvailablePlayers() []player.Player {
 
 // GetPlayersByPosition returns players who can play in a position
 func (t *Team) GetPlayersByPosition(pos player.Position) []player.Player {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	players := []player.Player{}
 	for _, p := range t.Players {
 		if p.CanPlayPosition(pos) {
@@ -178,19 +367,95 @@ func (t *Team) ValidateLineup(lineup Lineup) error {
 			return err
 		}
 		if !player.IsAvailable() {
-			return common.ErrPlayerUnavailable
+			return common.PlayerUnavailable(string(playerID))
 		}
 	}
 
 	// Check formation requirements
 	if !lineup.Formation.IsValid() {
-		return common.ErrInvalidFormation
+		return common.InvalidFormation(string(lineup.Formation))
 	}
 
 	// Validate positions match formation
 	return t.validateFormationPositions(lineup)
 }
 
+// ValidateLineupAll checks a lineup and reports every problem found
+func (t *Team) ValidateLineupAll(lineup Lineup) error {
+	var errs common.ValidationErrors
+
+	if len(lineup.Starters) != 11 {
+		errs.Add(common.ErrInsufficientPlayers.WithDetails(map[string]interface{}{
+			"starters": len(lineup.Starters),
+		}))
+	}
+
+	if !lineup.Formation.IsValid() {
+		errs.Add(common.InvalidFormation(string(lineup.Formation)))
+	}
+
+	if len(lineup.Positions) != len(lineup.Starters) {
+		errs.Add(common.ErrInvalidFormation.WithDetails(map[string]interface{}{
+			"starters":  len(lineup.Starters),
+			"positions": len(lineup.Positions),
+		}))
+	}
+
+	// Check each starter and count assigned positions
+	positionCount := make(map[player.Position]int)
+	captainStarting := false
+	for i, playerID := range lineup.Starters {
+		if playerID == lineup.Captain {
+			captainStarting = true
+		}
+
+		p, err := t.GetPlayer(playerID)
+		if err != nil {
+			errs.Add(common.PlayerNotFound(string(playerID)))
+			continue
+		}
+		if !p.IsAvailable() {
+			errs.Add(common.PlayerUnavailable(string(playerID)))
+		}
+
+		if i < len(lineup.Positions) {
+			assignedPos := lineup.Positions[i]
+			if !p.CanPlayPosition(assignedPos) {
+				errs.Add(common.ErrInvalidFormation.WithDetails(map[string]interface{}{
+					"player_id": string(playerID),
+					"position":  string(assignedPos),
+				}))
+			}
+			positionCount[assignedPos]++
+		}
+	}
+
+	// Check formation requirements
+	if lineup.Formation.IsValid() {
+		for _, pos := range []player.Position{
+			player.PositionGK,
+			player.PositionDEF,
+			player.PositionMID,
+			player.PositionFWD,
+		} {
+			required := lineup.Formation.GetPositionRequirements()[pos]
+			if positionCount[pos] != required {
+				errs.Add(common.ErrInvalidFormation.WithDetails(map[string]interface{}{
+					"position": string(pos),
+					"required": required,
+					"got":      positionCount[pos],
+				}))
+			}
+		}
+	}
+
+	if lineup.Captain == "" || !captainStarting {
+		errs.Add(common.ErrMissingCaptain)
+	}
+
+	return errs.ErrOrNil()
+}
+
 // validateFormationPositions ensures players are in correct positions
 func (t *Team) validateFormationPositions(lineup Lineup) error {
 	requiredPositions := lineup.Formation.GetPositionRequirements()
@@ -220,10 +485,6 @@ func (t *Team) validateFormationPositions(lineup Lineup) error {
 
 // GetTeamStrength calculates overall team strength
 func (t *Team) GetTeamStrength() float64 {
-	if len(t.Players) == 0 {
-		return 0
-	}
-
 	totalStrength := 0.0
 	count := 0
 
@@ -240,32 +501,98 @@ func (t *Team) GetTeamStrength() float64 {
 	return totalStrength / float64(count)
 }
 
-// GetBestEleven returns the strongest possible lineup
+// GetStrengthByLine rates each line of the team's strongest lineup in a
+// formation, averaging the starters' ratings in their assigned positions.
+// Lines the squad cannot fill are left at zero.
+func (t *Team) GetStrengthByLine(formation Formation) map[player.Position]float64 {
+	strength := map[player.Position]float64{
+		player.PositionGK:  0,
+		player.PositionDEF: 0,
+		player.PositionMID: 0,
+		player.PositionFWD: 0,
+	}
+
+	lineup, err := NewSquadManager(t).RecommendLineup(formation)
+	if err != nil {
+		return strength
+	}
+
+	counts := make(map[player.Position]int)
+	for i, id := range lineup.Starters {
+		p, err := t.GetPlayer(id)
+		if err != nil {
+			continue
+		}
+		pos := lineup.Positions[i]
+		strength[pos] += float64(p.GetRatingAtPosition(pos))
+		counts[pos]++
+	}
+	for pos, count := range counts {
+		strength[pos] /= float64(count)
+	}
+
+	return strength
+}
+
+// GetBestEleven returns the strongest possible lineup. Slots are filled in
+// fielding order with the highest rated player who can take them, each
+// player picked at most once, and a player is passed over when taking them
+// would leave a later slot unfilled that could otherwise be filled.
 func (t *Team) GetBestEleven() []player.Player {
 	available := t.GetAvailablePlayers()
 	if len(available) < 11 {
 		return available
 	}
 
-	// Simple selection: highest rated players per position
-	bestEleven := []player.Player{}
+	// Highest rated first, so the first fitting player is the best
+	sort.SliceStable(available, func(i, j int) bool {
+		return available[i].GetOverallRatingFloat() > available[j].GetOverallRatingFloat()
+	})
 
-	// Get required positions for current formation
-	requirements := t.Formation.GetPositionRequirements()
+	slots := formationSlots(t.Formation)
+	bestEleven := []player.Player{}
+	used := make([]bool, len(available))
 
-	for pos, count := range requirements {
-		candidates := []player.Player{}
-		for _, p := range available {
-			if p.CanPlayPosition(pos) {
-				candidates = append(candidates, p)
+	remaining := func() []player.Player {
+		pool := []player.Player{}
+		for i, p := range available {
+			if !used[i] {
+				pool = append(pool, p)
 			}
 		}
+		return pool
+	}
 
-		// Sort by rating and take required count
-		// (simplified - in real implementation would use proper sorting)
-		for i := 0; i < count && i < len(candidates); i++ {
-			bestEleven = append(bestEleven, candidates[i])
+	for slot, pos := range slots {
+		// Slots no selection from here on could fill
+		unfillable := len(unfilledSlots(slots[slot:], remaining()))
+
+		fallback := -1
+		picked := -1
+		for i, p := range available {
+			if used[i] || !p.CanPlayPosition(pos) {
+				continue
+			}
+			if fallback == -1 {
+				fallback = i
+			}
+			used[i] = true
+			fits := len(unfilledSlots(slots[slot+1:], remaining())) <= unfillable
+			used[i] = false
+			if fits {
+				picked = i
+				break
+			}
 		}
+		// A formation the squad can't fill is filled as far as it goes
+		if picked == -1 {
+			picked = fallback
+		}
+		if picked == -1 {
+			continue
+		}
+		used[picked] = true
+		bestEleven = append(bestEleven, available[picked])
 	}
 
 	return bestEleven
@@ -273,6 +600,9 @@ func (t *Team) GetBestEleven() []player.Player {
 
 // UpdateForm adds a match result to recent form
 func (t *Team) UpdateForm(result MatchResult) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
 	t.CurrentForm = append([]MatchResult{result}, t.CurrentForm...)
 	if len(t.CurrentForm) > 5 {
 		t.CurrentForm = t.CurrentForm[:5]
@@ -281,6 +611,9 @@ func (t *Team) UpdateForm(result MatchResult) {
 
 // GetFormString returns form as string (e.g., "WWLDW")
 func (t *Team) GetFormString() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
 	form := ""
 	for _, result := range t.CurrentForm {
 		form += result.Result
@@ -0,0 +1,378 @@
+// domain/team/team_test.go
+package team
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// newTestTeam creates an empty team with a default stadium
+func newTestTeam() *Team {
+	return NewTeam("t1", "Testers FC", Stadium{Name: "Test Park", Capacity: 30000})
+}
+
+// newTestPlayer creates a player of the given age in a position
+func newTestPlayer(id string, pos player.Position, age int) player.Player {
+	dob := time.Now().AddDate(-age, 0, -1)
+	return *player.NewPlayer(player.PlayerID(id), "Test", id, pos, dob)
+}
+
+// addTestSquad adds players to a team by position count
+func addTestSquad(t *testing.T, tm *Team, counts map[player.Position]int) {
+	t.Helper()
+	for _, pos := range []player.Position{player.PositionGK, player.PositionDEF, player.PositionMID, player.PositionFWD} {
+		for i := 0; i < counts[pos]; i++ {
+			p := newTestPlayer(fmt.Sprintf("%s%d", pos, i), pos, 25)
+			if err := tm.AddPlayer(p); err != nil {
+				t.Fatalf("AddPlayer(%s): %v", p.ID, err)
+			}
+		}
+	}
+}
+
+func TestTeamConcurrentAddRemove(t *testing.T) {
+	tm := newTestTeam()
+	tm.Rules.MaxSquadSize = 1000
+
+	const workers = 8
+	const perWorker = 25
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				id := fmt.Sprintf("p%d-%d", w, i)
+				if err := tm.AddPlayer(newTestPlayer(id, player.PositionMID, 25)); err != nil {
+					t.Errorf("AddPlayer(%s): %v", id, err)
+					return
+				}
+				_ = tm.UpdatePlayer(player.PlayerID(id), func(p *player.Player) { p.Morale++ })
+				tm.UpdateForm(MatchResult{Result: "W"})
+				tm.AddRival(TeamID(fmt.Sprintf("r%d", i%3)))
+				if _, err := tm.GetPlayer(player.PlayerID(id)); err != nil {
+					t.Errorf("GetPlayer(%s): %v", id, err)
+				}
+				if i%2 == 0 {
+					if err := tm.RemovePlayer(player.PlayerID(id)); err != nil {
+						t.Errorf("RemovePlayer(%s): %v", id, err)
+					}
+				}
+			}
+		}(w)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sm := NewSquadManager(tm)
+			for i := 0; i < perWorker; i++ {
+				sm.GetSquadValue()
+				sm.EnsureCaptain()
+				tm.GetSquadMorale()
+				NewFinancialManager(tm).GetTotalWages()
+			}
+		}()
+	}
+	wg.Wait()
+
+	wantCount := workers * (perWorker / 2) // even-numbered players are removed
+	if got := tm.PlayerCount(); got != wantCount {
+		t.Fatalf("PlayerCount() = %d, want %d", got, wantCount)
+	}
+	if got := len(tm.Rivals); got != 3 {
+		t.Errorf("len(Rivals) = %d, want 3", got)
+	}
+	for i, p := range tm.Players {
+		if got, ok := tm.index[p.ID]; !ok || got != i {
+			t.Errorf("index[%s] = %d, %v; want %d", p.ID, got, ok, i)
+		}
+	}
+}
+
+func TestTeamAddPlayerRejectsDuplicate(t *testing.T) {
+	tm := newTestTeam()
+	p := newTestPlayer("a", player.PositionDEF, 24)
+
+	if err := tm.AddPlayer(p); err != nil {
+		t.Fatalf("AddPlayer: %v", err)
+	}
+	if err := tm.AddPlayer(p); err == nil {
+		t.Fatal("AddPlayer accepted a duplicate")
+	}
+}
+
+func TestTeamRemovePlayerClearsCaptaincy(t *testing.T) {
+	tm := newTestTeam()
+	for _, id := range []string{"a", "b"} {
+		if err := tm.AddPlayer(newTestPlayer(id, player.PositionMID, 28)); err != nil {
+			t.Fatalf("AddPlayer(%s): %v", id, err)
+		}
+	}
+	if err := tm.SetCaptain("a"); err != nil {
+		t.Fatalf("SetCaptain: %v", err)
+	}
+	if err := tm.SetViceCaptain("a"); err == nil {
+		t.Error("SetViceCaptain accepted the captain")
+	}
+	if err := tm.SetViceCaptain("b"); err != nil {
+		t.Fatalf("SetViceCaptain: %v", err)
+	}
+
+	if err := tm.RemovePlayer("a"); err != nil {
+		t.Fatalf("RemovePlayer: %v", err)
+	}
+	if id := tm.CaptainID(); id != nil {
+		t.Errorf("CaptainID() = %s after removal, want nil", *id)
+	}
+	if id := tm.ViceCaptainID(); id == nil || *id != "b" {
+		t.Errorf("ViceCaptainID() = %v, want b", id)
+	}
+}
+
+func TestTeamUpdatePlayerUnknown(t *testing.T) {
+	tm := newTestTeam()
+	called := false
+	if err := tm.UpdatePlayer("missing", func(*player.Player) { called = true }); err == nil {
+		t.Error("UpdatePlayer succeeded for a missing player")
+	}
+	if called {
+		t.Error("UpdatePlayer ran the update for a missing player")
+	}
+}
+
+func TestGetBestElevenComparesUnroundedRatings(t *testing.T) {
+	tm := newTestTeam()
+	addTestSquad(t, tm, map[player.Position]int{
+		player.PositionDEF: 4, player.PositionMID: 4, player.PositionFWD: 2,
+	})
+
+	// Both keepers round to 75, but the second is rated 75.3
+	for i, speed := range []int{75, 78} {
+		p := newTestPlayer(fmt.Sprintf("keeper%d", i), player.PositionGK, 25)
+		for _, name := range player.AttributeNames() {
+			if err := p.Attributes.Set(name, 75); err != nil {
+				t.Fatal(err)
+			}
+		}
+		p.Attributes.Speed = speed
+		if got := p.GetOverallRating(); got != 75 {
+			t.Fatalf("%s rated %d, want both keepers to round to 75", p.ID, got)
+		}
+		if err := tm.AddPlayer(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, p := range tm.GetBestEleven() {
+		if p.Position == player.PositionGK && p.ID != "keeper1" {
+			t.Errorf("picked %s in goal, want the finer-rated keeper1", p.ID)
+		}
+	}
+}
+
+func TestTeamSquadReturnsCopy(t *testing.T) {
+	tm := newTestTeam()
+	tm.Rules.MaxSquadSize = 3
+	for _, id := range []string{"a", "b", "c"} {
+		if err := tm.AddPlayer(newTestPlayer(id, player.PositionMID, 25)); err != nil {
+			t.Fatalf("AddPlayer(%s): %v", id, err)
+		}
+	}
+	if err := tm.UpdatePlayer("a", func(p *player.Player) {
+		p.Loan = &player.LoanDeal{ParentTeamID: "parent"}
+		p.CareerStats.SeasonStats = []player.SeasonStats{{SeasonID: "2025", Goals: 4}}
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	squad := tm.Squad()
+	squad[0].Morale = 5
+	squad[0].Loan.ParentTeamID = "elsewhere"
+	squad[0].CareerStats.SeasonStats[0].Goals = 40
+	squad[1] = newTestPlayer("intruder", player.PositionFWD, 30)
+	_ = append(squad[:2], newTestPlayer("extra", player.PositionGK, 30))
+
+	if got := len(tm.Squad()); got != 3 {
+		t.Fatalf("squad has %d players after editing the copy, want 3", got)
+	}
+	a, err := tm.GetPlayer("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Morale == 5 || a.Loan.ParentTeamID != "parent" || a.CareerStats.SeasonStats[0].Goals != 4 {
+		t.Errorf("editing the copy changed the team's player: morale %.0f, loan %+v, stats %+v",
+			a.Morale, *a.Loan, a.CareerStats.SeasonStats)
+	}
+	for _, id := range []player.PlayerID{"b", "c"} {
+		if _, err := tm.GetPlayer(id); err != nil {
+			t.Errorf("GetPlayer(%s) after editing the copy: %v", id, err)
+		}
+	}
+	for _, id := range []player.PlayerID{"intruder", "extra"} {
+		if _, err := tm.GetPlayer(id); err == nil {
+			t.Errorf("GetPlayer(%s) found a player only added to the copy", id)
+		}
+	}
+	if err := tm.AddPlayer(newTestPlayer("d", player.PositionMID, 25)); err == nil {
+		t.Error("AddPlayer went past the squad limit after editing the copy")
+	}
+}
+
+func TestTeamIndexConsistentAfterRemovals(t *testing.T) {
+	tm := newTestTeam()
+	for i := 0; i < 30; i++ {
+		if err := tm.AddPlayer(newTestPlayer(fmt.Sprintf("p%02d", i), player.PositionMID, 25)); err != nil {
+			t.Fatalf("AddPlayer: %v", err)
+		}
+	}
+
+	// Remove from the front, middle and end
+	for _, id := range []player.PlayerID{"p00", "p15", "p29", "p07", "p01"} {
+		if err := tm.RemovePlayer(id); err != nil {
+			t.Fatalf("RemovePlayer(%s): %v", id, err)
+		}
+		if _, err := tm.GetPlayer(id); err == nil {
+			t.Errorf("GetPlayer(%s) found a removed player", id)
+		}
+		if err := tm.RemovePlayer(id); err == nil {
+			t.Errorf("RemovePlayer(%s) succeeded twice", id)
+		}
+	}
+
+	if len(tm.index) != len(tm.Players) {
+		t.Fatalf("index has %d entries for %d players", len(tm.index), len(tm.Players))
+	}
+	for i, p := range tm.Players {
+		if got := tm.index[p.ID]; got != i {
+			t.Errorf("index[%s] = %d, want %d", p.ID, got, i)
+		}
+		found, err := tm.GetPlayer(p.ID)
+		if err != nil || found.ID != p.ID {
+			t.Errorf("GetPlayer(%s) = %v, %v", p.ID, found, err)
+		}
+	}
+
+	// Re-adding a removed player indexes it at the end
+	if err := tm.AddPlayer(newTestPlayer("p15", player.PositionMID, 25)); err != nil {
+		t.Fatalf("AddPlayer(p15): %v", err)
+	}
+	if got := tm.index["p15"]; got != len(tm.Players)-1 {
+		t.Errorf("index[p15] = %d, want %d", got, len(tm.Players)-1)
+	}
+}
+
+func TestTeamIndexRebuiltOnRestore(t *testing.T) {
+	tm := newTestTeam()
+	for i := 0; i < 5; i++ {
+		if err := tm.AddPlayer(newTestPlayer(fmt.Sprintf("p%d", i), player.PositionDEF, 25)); err != nil {
+			t.Fatalf("AddPlayer: %v", err)
+		}
+	}
+
+	restored := RestoreTeam(tm.Snapshot())
+	for _, p := range tm.Players {
+		if _, err := restored.GetPlayer(p.ID); err != nil {
+			t.Errorf("restored GetPlayer(%s): %v", p.ID, err)
+		}
+	}
+	if len(restored.index) != len(restored.Players) {
+		t.Errorf("restored index has %d entries for %d players", len(restored.index), len(restored.Players))
+	}
+}
+
+// newTestLineup returns a valid 4-4-2 lineup for a squad built by
+// addTestSquad, captained by the keeper
+func newTestLineup() Lineup {
+	lineup := Lineup{Formation: Formation442, Captain: "GK0"}
+	for _, pos := range []player.Position{player.PositionGK, player.PositionDEF, player.PositionMID, player.PositionFWD} {
+		for i := 0; i < Formation442.GetPositionRequirements()[pos]; i++ {
+			lineup.Starters = append(lineup.Starters, player.PlayerID(fmt.Sprintf("%s%d", pos, i)))
+			lineup.Positions = append(lineup.Positions, pos)
+		}
+	}
+	return lineup
+}
+
+func TestValidateLineupAll(t *testing.T) {
+	tm := newTestTeam()
+	addTestSquad(t, tm, map[player.Position]int{
+		player.PositionGK:  1,
+		player.PositionDEF: 4,
+		player.PositionMID: 4,
+		player.PositionFWD: 2,
+	})
+
+	if err := tm.ValidateLineupAll(newTestLineup()); err != nil {
+		t.Fatalf("valid lineup: ValidateLineupAll() = %v, want nil", err)
+	}
+
+	// Ten starters with the last forward dropped, a suspended defender, no
+	// captain and a defender filling a midfield slot
+	if err := tm.UpdatePlayer("DEF0", func(p *player.Player) { p.Status = player.StatusSuspended }); err != nil {
+		t.Fatal(err)
+	}
+	lineup := newTestLineup()
+	lineup.Starters = lineup.Starters[:10]
+	lineup.Positions = lineup.Positions[:10]
+	lineup.Captain = ""
+	for i, id := range lineup.Starters {
+		if id == "DEF1" {
+			lineup.Positions[i] = player.PositionMID
+		}
+	}
+
+	err := tm.ValidateLineupAll(lineup)
+	var errs common.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("ValidateLineupAll() = %v, want ValidationErrors", err)
+	}
+
+	tests := []struct {
+		name string
+		want common.DomainError
+	}{
+		{"ten starters", common.ErrInsufficientPlayers},
+		{"unavailable player", common.ErrPlayerUnavailable},
+		{"missing captain", common.ErrMissingCaptain},
+		{"out of position", common.ErrInvalidFormation},
+	}
+	for _, tt := range tests {
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: %v does not report %s", tt.name, err, tt.want.Code)
+		}
+	}
+
+	misplaced := false
+	for _, e := range errs {
+		if e.Is(common.ErrInvalidFormation) && e.Details["player_id"] == "DEF1" {
+			misplaced = true
+		}
+	}
+	if !misplaced {
+		t.Errorf("%v does not name DEF1 as out of position", err)
+	}
+}
+
+func BenchmarkTeamGetPlayer(b *testing.B) {
+	tm := newTestTeam()
+	ids := make([]player.PlayerID, 30)
+	for i := range ids {
+		ids[i] = player.PlayerID(fmt.Sprintf("p%02d", i))
+		if err := tm.AddPlayer(newTestPlayer(string(ids[i]), player.PositionMID, 25)); err != nil {
+			b.Fatalf("AddPlayer: %v", err)
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := tm.GetPlayer(ids[i%len(ids)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+// domain/team/wages.go
+package team
+
+import (
+	"sort"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// Peer matching and disparity thresholds
+const (
+	peerRatingRange = 5   // Rating points either side of the player
+	peerAgeRange    = 4   // Years either side of the player
+	minWagePeers    = 2   // Fewer peers gives no reliable benchmark
+	overpaidRatio   = 2.0 // Wage at or above this multiple is overpaid
+	underpaidRatio  = 0.5 // Wage at or below this multiple is underpaid
+)
+
+// WageFlagKind describes which way a wage is out of line
+type WageFlagKind string
+
+const (
+	WageOverpaid  WageFlagKind = "overpaid"
+	WageUnderpaid WageFlagKind = "underpaid"
+)
+
+// WageFlag is a player paid well out of line with squad peers
+type WageFlag struct {
+	PlayerID      player.PlayerID
+	Kind          WageFlagKind
+	Wage          int64
+	BenchmarkWage int64   // Median wage of peers with similar rating and age
+	Ratio         float64 // Wage as a multiple of the benchmark
+}
+
+// DetectWageDisparity flags players paid far above or below squad peers of
+// similar rating and age, biggest disparity first. Unfair wages are a
+// common source of dressing-room unrest.
+func (sm *SquadManager) DetectWageDisparity() []WageFlag {
+	flags := []WageFlag{}
+
+	squad := sm.team.players()
+	for i := range squad {
+		p := &squad[i]
+		if p.Wage <= 0 {
+			continue
+		}
+
+		benchmark, ok := peerWageBenchmark(p, squad)
+		if !ok {
+			continue
+		}
+
+		ratio := float64(p.Wage) / float64(benchmark)
+		flag := WageFlag{
+			PlayerID:      p.ID,
+			Wage:          p.Wage,
+			BenchmarkWage: benchmark,
+			Ratio:         ratio,
+		}
+		switch {
+		case ratio >= overpaidRatio:
+			flag.Kind = WageOverpaid
+		case ratio <= underpaidRatio:
+			flag.Kind = WageUnderpaid
+		default:
+			continue
+		}
+		flags = append(flags, flag)
+	}
+
+	sort.SliceStable(flags, func(i, j int) bool {
+		return disparity(flags[i].Ratio) > disparity(flags[j].Ratio)
+	})
+
+	return flags
+}
+
+// peerWageBenchmark returns the median wage of a player's peers in a squad
+func peerWageBenchmark(p *player.Player, squad []player.Player) (int64, bool) {
+	rating := p.GetOverallRating()
+	age := p.Age()
+
+	wages := []int64{}
+	for _, other := range squad {
+		if other.ID == p.ID || other.Wage <= 0 {
+			continue
+		}
+		if abs(other.GetOverallRating()-rating) > peerRatingRange || abs(other.Age()-age) > peerAgeRange {
+			continue
+		}
+		wages = append(wages, other.Wage)
+	}
+	if len(wages) < minWagePeers {
+		return 0, false
+	}
+
+	sort.Slice(wages, func(i, j int) bool { return wages[i] < wages[j] })
+	mid := len(wages) / 2
+	if len(wages)%2 == 0 {
+		return (wages[mid-1] + wages[mid]) / 2, true
+	}
+	return wages[mid], true
+}
+
+// disparity measures how far a wage ratio is from parity in either
+// direction
+func disparity(ratio float64) float64 {
+	if ratio < 1 {
+		return 1 / ratio
+	}
+	return ratio
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,68 @@
+// domain/team/wages_test.go
+package team
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// addPaidPlayers adds identically rated midfielders of one age on the
+// given wages, named MID0, MID1 and so on
+func addPaidPlayers(t *testing.T, tm *Team, age int, wages ...int64) {
+	t.Helper()
+	offset := tm.PlayerCount()
+	for i, wage := range wages {
+		p := newTestPlayer(fmt.Sprintf("MID%d", offset+i), player.PositionMID, age)
+		p.Wage = wage
+		if err := tm.AddPlayer(p); err != nil {
+			t.Fatalf("AddPlayer(%s): %v", p.ID, err)
+		}
+	}
+}
+
+func TestDetectWageDisparity(t *testing.T) {
+	tests := []struct {
+		name  string
+		setup func(t *testing.T, tm *Team)
+		want  []WageFlag
+	}{
+		{
+			"backup on star wages",
+			func(t *testing.T, tm *Team) { addPaidPlayers(t, tm, 25, 1000, 1200, 1400, 5000) },
+			[]WageFlag{{PlayerID: "MID3", Kind: WageOverpaid, Wage: 5000, BenchmarkWage: 1200, Ratio: 5000.0 / 1200}},
+		},
+		{
+			"benchmark is the median of an even peer group",
+			func(t *testing.T, tm *Team) { addPaidPlayers(t, tm, 25, 1000, 1000, 1400, 1400, 400) },
+			[]WageFlag{{PlayerID: "MID4", Kind: WageUnderpaid, Wage: 400, BenchmarkWage: 1200, Ratio: 400.0 / 1200}},
+		},
+		{
+			"too few peers",
+			func(t *testing.T, tm *Team) { addPaidPlayers(t, tm, 25, 1000, 5000) },
+			[]WageFlag{},
+		},
+		{
+			"peers outside the age range",
+			func(t *testing.T, tm *Team) {
+				addPaidPlayers(t, tm, 25, 5000)
+				addPaidPlayers(t, tm, 25+peerAgeRange+1, 1000, 1000, 1000)
+			},
+			[]WageFlag{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := newTestTeam()
+			tt.setup(t, tm)
+
+			got := NewSquadManager(tm).DetectWageDisparity()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DetectWageDisparity() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
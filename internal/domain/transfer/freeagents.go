@@ -0,0 +1,126 @@
+// domain/transfer/freeagents.go
+package transfer
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// signingBonusWeeks is the signing-on fee a free agent asks for, in weeks
+// of wages
+const signingBonusWeeks = 4
+
+// FreeAgents is the pool of players without a club
+type FreeAgents struct {
+	players []player.Player
+}
+
+// NewFreeAgents creates an empty free-agent pool
+func NewFreeAgents() *FreeAgents {
+	return &FreeAgents{players: []player.Player{}}
+}
+
+// Add places a player in the pool, replacing any existing entry. A player
+// on loan returns to being available, which fails while they are still
+// serving a ban.
+func (fa *FreeAgents) Add(p player.Player) error {
+	if err := release(&p); err != nil {
+		return err
+	}
+	fa.put(p)
+	return nil
+}
+
+// release cuts a player's ties to their club
+func release(p *player.Player) error {
+	if p.Status == player.StatusOnLoan {
+		if err := p.SetStatus(player.StatusAvailable); err != nil {
+			return err
+		}
+	}
+	p.CurrentTeamID = ""
+	p.Loan = nil
+	return nil
+}
+
+// put stores a player in the pool, replacing any existing entry
+func (fa *FreeAgents) put(p player.Player) {
+	for i, existing := range fa.players {
+		if existing.ID == p.ID {
+			fa.players[i] = p
+			return
+		}
+	}
+	fa.players = append(fa.players, p)
+}
+
+// Len returns the number of players in the pool
+func (fa *FreeAgents) Len() int {
+	return len(fa.players)
+}
+
+// SigningBonus returns the one-off fee a free agent expects to sign
+func SigningBonus(p player.Player) int64 {
+	return p.Wage * signingBonusWeeks
+}
+
+// SignableBy returns the players a club can afford, following the same
+// rules as FinancialManager.CanAffordTransfer, best players first
+func (fa *FreeAgents) SignableBy(budget, wageBudget int64) []player.Player {
+	signable := []player.Player{}
+	for _, p := range fa.players {
+		if p.Status == player.StatusRetired {
+			continue
+		}
+		if SigningBonus(p) > budget || p.Wage > wageBudget {
+			continue
+		}
+		signable = append(signable, p)
+	}
+
+	sort.SliceStable(signable, func(i, j int) bool {
+		return signable[i].GetOverallRatingFloat() > signable[j].GetOverallRatingFloat()
+	})
+
+	return signable
+}
+
+// Sign removes a player from the pool and returns them
+func (fa *FreeAgents) Sign(id player.PlayerID) (player.Player, error) {
+	for i, p := range fa.players {
+		if p.ID == id {
+			fa.players = append(fa.players[:i], fa.players[i+1:]...)
+			p.UpdatedAt = time.Now()
+			return p, nil
+		}
+	}
+	return player.Player{}, common.PlayerNotFound(string(id))
+}
+
+// ReleaseExpiredContracts moves players whose contracts ended before the
+// given time from the team into the pool. Players who cannot be released
+// stay with the team and their errors are joined in the result.
+func (fa *FreeAgents) ReleaseExpiredContracts(t *team.Team, at time.Time) ([]player.PlayerID, error) {
+	released := []player.PlayerID{}
+	var errs []error
+	for _, p := range t.Squad() {
+		if p.ContractUntil.IsZero() || p.ContractUntil.After(at) {
+			continue
+		}
+		if err := release(&p); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := t.RemovePlayer(p.ID); err != nil {
+			continue
+		}
+		fa.put(p)
+		released = append(released, p.ID)
+	}
+	return released, errors.Join(errs...)
+}
@@ -0,0 +1,158 @@
+// domain/transfer/freeagents_test.go
+package transfer
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+	"github.com/devvspaces/fantasy_league/internal/domain/team"
+)
+
+// newFreeAgent creates a player of the given quality asking for a wage
+func newFreeAgent(t *testing.T, id string, quality int, wage int64) player.Player {
+	t.Helper()
+	p := player.NewPlayer(player.PlayerID(id), "Test", id, player.PositionMID, time.Now().AddDate(-27, 0, -1))
+	if err := p.Attributes.ScaleToQuality(quality); err != nil {
+		t.Fatal(err)
+	}
+	p.RecomputeRating()
+	p.Wage = wage
+	return *p
+}
+
+func TestFreeAgentsSignableBy(t *testing.T) {
+	fa := NewFreeAgents()
+	for _, p := range []player.Player{
+		newFreeAgent(t, "cheap", 60, 1000),
+		newFreeAgent(t, "star", 85, 5000),
+		newFreeAgent(t, "solid", 72, 2000),
+		newFreeAgent(t, "pricey", 90, 20000),
+	} {
+		if err := fa.Add(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	retired := newFreeAgent(t, "retired", 95, 100)
+	retired.Status = player.StatusRetired
+	if err := fa.Add(retired); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		budget     int64
+		wageBudget int64
+		want       []player.PlayerID
+	}{
+		{"everyone affordable, best first", 1000000, 100000, []player.PlayerID{"pricey", "star", "solid", "cheap"}},
+		{"wage budget rules out the star", 1000000, 2000, []player.PlayerID{"solid", "cheap"}},
+		{"signing bonus limited by budget", 4 * 2000, 100000, []player.PlayerID{"solid", "cheap"}},
+		{"nothing affordable", 0, 0, []player.PlayerID{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []player.PlayerID{}
+			for _, p := range fa.SignableBy(tt.budget, tt.wageBudget) {
+				got = append(got, p.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SignableBy(%d, %d) = %v, want %v", tt.budget, tt.wageBudget, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFreeAgentsSign(t *testing.T) {
+	fa := NewFreeAgents()
+	if err := fa.Add(newFreeAgent(t, "a", 70, 1000)); err != nil {
+		t.Fatal(err)
+	}
+	if err := fa.Add(newFreeAgent(t, "b", 70, 1000)); err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := fa.Sign("a")
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	if p.ID != "a" || fa.Len() != 1 {
+		t.Errorf("signed %s leaving %d in the pool, want a leaving 1", p.ID, fa.Len())
+	}
+	if _, err := fa.Sign("a"); !errors.Is(err, common.ErrPlayerNotFound) {
+		t.Errorf("signing a twice = %v, want ErrPlayerNotFound", err)
+	}
+}
+
+func TestFreeAgentsAddClearsClubTies(t *testing.T) {
+	p := newFreeAgent(t, "loanee", 70, 1000)
+	p.CurrentTeamID = "club"
+	if err := InitiateLoan(&p, 0.5, 6); err != nil {
+		t.Fatal(err)
+	}
+
+	fa := NewFreeAgents()
+	if err := fa.Add(p); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	signed, err := fa.Sign("loanee")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signed.CurrentTeamID != "" || signed.Loan != nil || signed.Status != player.StatusAvailable {
+		t.Errorf("free agent team %q, loan %+v, status %s; want no club, no loan and available", signed.CurrentTeamID, signed.Loan, signed.Status)
+	}
+}
+
+func TestReleaseExpiredContracts(t *testing.T) {
+	now := time.Now()
+	tm := team.NewTeam("club", "Club", team.Stadium{Name: "Ground", Capacity: 20000})
+	contracts := []struct {
+		id    string
+		until time.Time
+	}{
+		{"expired", now.AddDate(0, -1, 0)},
+		{"running", now.AddDate(1, 0, 0)},
+		{"open-ended", time.Time{}},
+		{"banned-loanee", now.AddDate(0, -1, 0)},
+	}
+	for _, c := range contracts {
+		p := newFreeAgent(t, c.id, 70, 1000)
+		p.CurrentTeamID = "club"
+		p.ContractUntil = c.until
+		if err := tm.AddPlayer(p); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A loanee still serving a ban can't be made available yet
+	if err := tm.UpdatePlayer("banned-loanee", func(p *player.Player) {
+		p.Status = player.StatusOnLoan
+		p.SuspensionGames = 2
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	fa := NewFreeAgents()
+	released, err := fa.ReleaseExpiredContracts(tm, now)
+	if !errors.Is(err, common.ErrInvalidStatusTransition) {
+		t.Errorf("ReleaseExpiredContracts error = %v, want ErrInvalidStatusTransition for the banned loanee", err)
+	}
+	if want := []player.PlayerID{"expired"}; !reflect.DeepEqual(released, want) {
+		t.Errorf("released %v, want %v", released, want)
+	}
+	if fa.Len() != 1 {
+		t.Errorf("pool holds %d players, want 1", fa.Len())
+	}
+	for _, id := range []player.PlayerID{"running", "open-ended", "banned-loanee"} {
+		if _, err := tm.GetPlayer(id); err != nil {
+			t.Errorf("%s left the club: %v", id, err)
+		}
+	}
+	if _, err := tm.GetPlayer("expired"); err == nil {
+		t.Error("expired contract still at the club")
+	}
+}
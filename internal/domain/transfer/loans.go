@@ -0,0 +1,59 @@
+// domain/transfer/loans.go
+package transfer
+
+import (
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// InitiateLoan sends a player out on loan, with the loan club paying
+// wageShare (0-1) of the wage for the given number of months
+func InitiateLoan(p *player.Player, wageShare float64, months int) error {
+	if p.Status == player.StatusOnLoan || p.Status == player.StatusRetired {
+		return common.PlayerUnavailable(string(p.ID))
+	}
+	if wageShare < 0 || wageShare > 1 {
+		return common.ErrInvalidLoan.WithDetails(map[string]interface{}{"wage_share": wageShare})
+	}
+	if months <= 0 {
+		return common.ErrInvalidLoan.WithDetails(map[string]interface{}{"months": months})
+	}
+
+	if err := p.SetStatus(player.StatusOnLoan); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	p.Loan = &player.LoanDeal{
+		ParentTeamID: p.CurrentTeamID,
+		WageShare:    wageShare,
+		StartDate:    now,
+		EndDate:      now.AddDate(0, months, 0),
+	}
+	p.UpdatedAt = now
+
+	return nil
+}
+
+// RecallLoan returns a loaned player to their parent club. It reports
+// whether the recall cut the loan short of its agreed term.
+func RecallLoan(p *player.Player) (bool, error) {
+	if p.Status != player.StatusOnLoan || p.Loan == nil {
+		return false, common.ErrInvalidLoan.WithDetails(map[string]interface{}{"player_id": string(p.ID)})
+	}
+
+	if err := p.SetStatus(player.StatusAvailable); err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	early := !p.Loan.HasEnded(now)
+
+	p.CurrentTeamID = p.Loan.ParentTeamID
+	p.Loan = nil
+	p.UpdatedAt = now
+
+	return early, nil
+}
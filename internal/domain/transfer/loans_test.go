@@ -0,0 +1,45 @@
+// domain/transfer/loans_test.go
+package transfer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/common"
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+func TestLoanStatusTransitions(t *testing.T) {
+	p := newValuedPlayer(22, 70, 1000000)
+	p.CurrentTeamID = "parent"
+
+	if err := InitiateLoan(p, 0.5, 6); err != nil {
+		t.Fatalf("InitiateLoan: %v", err)
+	}
+	if p.Status != player.StatusOnLoan || p.Loan == nil {
+		t.Fatalf("Status = %s, Loan = %+v; want on loan", p.Status, p.Loan)
+	}
+
+	early, err := RecallLoan(p)
+	if err != nil {
+		t.Fatalf("RecallLoan: %v", err)
+	}
+	if !early || p.Status != player.StatusAvailable || p.Loan != nil || p.CurrentTeamID != "parent" {
+		t.Errorf("after recall: early %v, Status %s, Loan %+v, team %s", early, p.Status, p.Loan, p.CurrentTeamID)
+	}
+}
+
+func TestInitiateLoanRejectsInjuredPlayer(t *testing.T) {
+	p := newValuedPlayer(22, 70, 1000000)
+	if err := p.ApplyInjury(player.Injury{Type: player.InjuryKnock, Days: 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := InitiateLoan(p, 0.5, 6)
+	if !errors.Is(err, common.ErrInvalidStatusTransition) {
+		t.Fatalf("InitiateLoan = %v, want ErrInvalidStatusTransition", err)
+	}
+	if p.Status != player.StatusInjured || p.Loan != nil {
+		t.Errorf("Status = %s, Loan = %+v; want an injured player left at home", p.Status, p.Loan)
+	}
+}
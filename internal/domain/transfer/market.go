@@ -0,0 +1,31 @@
+// domain/transfer/market.go
+package transfer
+
+// BidDecision is the selling club's answer to a bid
+type BidDecision string
+
+const (
+	BidAccepted  BidDecision = "accepted"
+	BidRejected  BidDecision = "rejected"
+	BidCountered BidDecision = "countered"
+)
+
+// BidReason explains why a bid received its decision
+type BidReason string
+
+const (
+	ReasonMeetsValuation   BidReason = "meets_valuation"
+	ReasonAboveValuation   BidReason = "above_valuation"
+	ReasonBelowValuation   BidReason = "below_valuation"
+	ReasonLowball          BidReason = "lowball"
+	ReasonContractExpiring BidReason = "contract_expiring"
+	ReasonNotForSale       BidReason = "not_for_sale"
+)
+
+// BidResponse is the outcome of evaluating a bid
+type BidResponse struct {
+	Decision     BidDecision
+	AskingPrice  int64
+	CounterOffer int64 // Set only when the bid is countered
+	Reason       BidReason
+}
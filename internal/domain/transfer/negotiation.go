@@ -0,0 +1,56 @@
+// domain/transfer/negotiation.go
+package transfer
+
+import (
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// Bid thresholds relative to the asking price
+const (
+	generousBidRatio  = 1.25 // Accepted without hesitation
+	lowballBidRatio   = 0.5  // Rejected outright
+	expiringBidRatio  = 0.8  // Accepted for players about to leave
+	expiringThreshold = 0.5  // Years left on a contract considered expiring
+)
+
+// EvaluateBid decides whether the selling club accepts, rejects or
+// counters a bid for a player
+func EvaluateBid(p *player.Player, bid int64) BidResponse {
+	asking := AskingPrice(p)
+	response := BidResponse{AskingPrice: asking}
+
+	if p.Status == player.StatusRetired || p.Status == player.StatusOnLoan {
+		response.Decision = BidRejected
+		response.Reason = ReasonNotForSale
+		return response
+	}
+
+	if asking <= 0 || bid >= asking {
+		response.Decision = BidAccepted
+		response.Reason = ReasonMeetsValuation
+		if asking > 0 && float64(bid) >= float64(asking)*generousBidRatio {
+			response.Reason = ReasonAboveValuation
+		}
+		return response
+	}
+
+	ratio := float64(bid) / float64(asking)
+
+	// A club would rather cash in than lose a player for nothing
+	if contractYearsLeft(p) < expiringThreshold && ratio >= expiringBidRatio {
+		response.Decision = BidAccepted
+		response.Reason = ReasonContractExpiring
+		return response
+	}
+
+	if ratio < lowballBidRatio {
+		response.Decision = BidRejected
+		response.Reason = ReasonLowball
+		return response
+	}
+
+	response.Decision = BidCountered
+	response.Reason = ReasonBelowValuation
+	response.CounterOffer = asking
+	return response
+}
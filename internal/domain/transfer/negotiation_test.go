@@ -0,0 +1,59 @@
+// domain/transfer/negotiation_test.go
+package transfer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+func TestEvaluateBid(t *testing.T) {
+	expiring := func(p *player.Player) { p.ContractUntil = time.Now().AddDate(0, 2, 0) }
+
+	tests := []struct {
+		name         string
+		setup        func(p *player.Player)
+		ratio        float64 // Bid as a share of the asking price
+		wantDecision BidDecision
+		wantReason   BidReason
+	}{
+		{"at the asking price", nil, 1, BidAccepted, ReasonMeetsValuation},
+		{"above the asking price", nil, 1.1, BidAccepted, ReasonMeetsValuation},
+		{"well above the asking price", nil, 1.3, BidAccepted, ReasonAboveValuation},
+		{"expiring contract, fair bid", expiring, 0.85, BidAccepted, ReasonContractExpiring},
+		{"expiring contract, low bid", expiring, 0.6, BidCountered, ReasonBelowValuation},
+		{"lowball", nil, 0.4, BidRejected, ReasonLowball},
+		{"in between", nil, 0.8, BidCountered, ReasonBelowValuation},
+		{"retired", func(p *player.Player) { p.Status = player.StatusRetired }, 2, BidRejected, ReasonNotForSale},
+		{"on loan", func(p *player.Player) { p.Status = player.StatusOnLoan }, 2, BidRejected, ReasonNotForSale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newValuedPlayer(27, 70, 10000000)
+			p.ContractUntil = time.Now().AddDate(3, 0, 0)
+			if tt.setup != nil {
+				tt.setup(p)
+			}
+			asking := AskingPrice(p)
+			bid := int64(float64(asking) * tt.ratio)
+
+			got := EvaluateBid(p, bid)
+			if got.Decision != tt.wantDecision || got.Reason != tt.wantReason {
+				t.Errorf("EvaluateBid() = %s (%s), want %s (%s)", got.Decision, got.Reason, tt.wantDecision, tt.wantReason)
+			}
+			if got.AskingPrice != asking {
+				t.Errorf("AskingPrice = %d, want %d", got.AskingPrice, asking)
+			}
+
+			wantCounter := int64(0)
+			if tt.wantDecision == BidCountered {
+				wantCounter = asking
+			}
+			if got.CounterOffer != wantCounter {
+				t.Errorf("CounterOffer = %d, want %d", got.CounterOffer, wantCounter)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// domain/transfer/valuation.go
+package transfer
+
+import (
+	"math"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// AskingPrice is the fee a club wants for a player, adjusting the market
+// value for form, age and contract length
+func AskingPrice(p *player.Player) int64 {
+	value := float64(p.MarketValue)
+
+	// Form swings the price by up to 15% either way
+	value *= 0.85 + 0.3*p.Form/100
+
+	// Young players carry a premium, veterans a discount
+	switch age := p.Age(); {
+	case age <= 23:
+		value *= 1.15
+	case age >= 31:
+		value *= 0.8
+	}
+
+	// Clubs lose leverage as a contract runs down
+	value *= contractModifier(contractYearsLeft(p))
+
+	return int64(math.Round(value/1000) * 1000)
+}
+
+// Monthly value drift by age, before form is taken into account
+const (
+	youngMonthlyDrift   = 0.005  // 23 and under
+	primeMonthlyDrift   = 0.0    // 24-27
+	matureMonthlyDrift  = -0.005 // 28-30
+	ageingMonthlyDrift  = -0.015 // 31-32
+	veteranMonthlyDrift = -0.03  // Over 32
+	neutralForm         = 70.0
+	formDriftScale      = 1500.0 // Form points per 1% monthly drift
+)
+
+// DepreciateValues moves each player's market value on by the given number
+// of months. Ageing players lose value, fastest past 32, while young
+// players in form gain it.
+func DepreciateValues(players []*player.Player, monthsElapsed int) {
+	if monthsElapsed <= 0 {
+		return
+	}
+
+	for _, p := range players {
+		if p == nil || p.MarketValue <= 0 {
+			continue
+		}
+
+		rate := ageDrift(p.Age()) + (p.Form-neutralForm)/formDriftScale
+		value := float64(p.MarketValue) * math.Pow(1+rate, float64(monthsElapsed))
+		p.MarketValue = int64(math.Round(value/1000) * 1000)
+	}
+}
+
+// ageDrift returns the monthly value change for a player's age
+func ageDrift(age int) float64 {
+	switch {
+	case age <= 23:
+		return youngMonthlyDrift
+	case age <= 27:
+		return primeMonthlyDrift
+	case age <= 30:
+		return matureMonthlyDrift
+	case age <= 32:
+		return ageingMonthlyDrift
+	default:
+		return veteranMonthlyDrift
+	}
+}
+
+// contractYearsLeft returns the years remaining on a player's contract
+func contractYearsLeft(p *player.Player) float64 {
+	remaining := time.Until(p.ContractUntil).Hours() / (24 * 365)
+	return math.Max(0, remaining)
+}
+
+// contractModifier scales value by the years left on a contract
+func contractModifier(years float64) float64 {
+	switch {
+	case years < 1:
+		return 0.6
+	case years < 2:
+		return 0.85
+	default:
+		return math.Min(1+0.05*(years-2), 1.15)
+	}
+}
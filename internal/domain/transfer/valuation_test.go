@@ -0,0 +1,69 @@
+// domain/transfer/valuation_test.go
+package transfer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/devvspaces/fantasy_league/internal/domain/player"
+)
+
+// newValuedPlayer creates a player of the given age, form and market value
+func newValuedPlayer(age int, form float64, value int64) *player.Player {
+	p := player.NewPlayer("p", "Test", "Player", player.PositionMID, time.Now().AddDate(-age, 0, -1))
+	p.Form = form
+	p.MarketValue = value
+	return p
+}
+
+func TestDepreciateValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		age    int
+		form   float64
+		months int
+		check  func(before, after int64) bool
+		want   string
+	}{
+		{"34-year-old loses value", 34, 70, 6, func(b, a int64) bool { return a < b }, "lower"},
+		{"20-year-old in form appreciates", 20, 90, 6, func(b, a int64) bool { return a > b }, "higher"},
+		{"20-year-old out of form slips", 20, 40, 6, func(b, a int64) bool { return a < b }, "lower"},
+		{"prime player at neutral form holds", 25, 70, 6, func(b, a int64) bool { return a == b }, "unchanged"},
+		{"no time elapsed", 34, 70, 0, func(b, a int64) bool { return a == b }, "unchanged"},
+		{"negative months ignored", 34, 70, -3, func(b, a int64) bool { return a == b }, "unchanged"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newValuedPlayer(tt.age, tt.form, 10000000)
+			DepreciateValues([]*player.Player{p}, tt.months)
+			if !tt.check(10000000, p.MarketValue) {
+				t.Errorf("MarketValue = %d, want %s than 10000000", p.MarketValue, tt.want)
+			}
+		})
+	}
+}
+
+func TestDepreciateValuesVeteransFallFastest(t *testing.T) {
+	ages := []int{26, 29, 31, 33}
+	players := make([]*player.Player, len(ages))
+	for i, age := range ages {
+		players[i] = newValuedPlayer(age, 70, 10000000)
+	}
+	DepreciateValues(append(players, nil), 12)
+
+	for i := 1; i < len(players); i++ {
+		if players[i].MarketValue >= players[i-1].MarketValue {
+			t.Errorf("value at %d = %d, at %d = %d; want older players to lose more",
+				ages[i], players[i].MarketValue, ages[i-1], players[i-1].MarketValue)
+		}
+	}
+}
+
+func TestDepreciateValuesSkipsUnvalued(t *testing.T) {
+	p := newValuedPlayer(34, 70, 0)
+	DepreciateValues([]*player.Player{p}, 12)
+	if p.MarketValue != 0 {
+		t.Errorf("MarketValue = %d, want 0 for an unvalued player", p.MarketValue)
+	}
+}